@@ -3,7 +3,9 @@ package config
 import (
 	"flag"
 	"fmt"
+	"path"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 )
@@ -78,6 +80,49 @@ const (
 	FixModeSmart FixMode = "smart"
 )
 
+// ReceiverInput specifies when a method's receiver is taken as the converter input.
+type ReceiverInput string
+
+const (
+	// ReceiverFallback: the receiver is the input only when no parameter is a struct.
+	// Example: func (u User) ToDTO() UserDTO → u is the input.
+	// Example: func (m *Mapper) ToDTO(u User) UserDTO → u is the input, m is not checked.
+	ReceiverFallback ReceiverInput = "fallback"
+
+	// ReceiverPrefer: a struct receiver is always the input; struct parameters become
+	// additional inputs.
+	// Example: func (u User) ToDTO(s Settings) UserDTO → u is the input, s an additional one.
+	ReceiverPrefer ReceiverInput = "prefer"
+)
+
+// NameMatching specifies how input and output type names are paired.
+type NameMatching string
+
+const (
+	// NameMatchingContainment: one lowercased name must contain the other.
+	// Example: User → UserDTO matches; UserRecord → UserDTO does not.
+	NameMatchingContainment NameMatching = "containment"
+
+	// NameMatchingTokens: names are split into CamelCase tokens, the configured affixes
+	// are stripped from both ends, and one token sequence must contain the other.
+	// Example: UserRecord → UserDTO matches (both are "User"); Order → BorderDTO does not.
+	NameMatchingTokens NameMatching = "tokens"
+)
+
+// CopyHandling specifies what a reflective copy call (copier.Copy) in a converter means.
+type CopyHandling string
+
+const (
+	// CopyHandlingMatch: fields are paired by name, case-insensitively, the way copy
+	// libraries pair them; fields without a counterpart are reported as an unchecked
+	// reflective copy.
+	// Example: copier.Copy(&out, &in) with in.Login and out.Username → both reported.
+	CopyHandlingMatch CopyHandling = "match"
+
+	// CopyHandlingTrust: a copy call covers every field of both sides.
+	CopyHandlingTrust CopyHandling = "trust"
+)
+
 // Config holds all configuration for the analyzer.
 //
 // The json tags are the canonical setting names: they match the CLI flag names and are
@@ -87,10 +132,73 @@ type Config struct {
 	// Default: true
 	AllowMethodConverters bool `json:"include-methods" mapstructure:"include-methods"`
 
+	// ReceiverInput specifies when a method's receiver is the converter input, which makes
+	// receiver-only methods like `func (u User) ToDTO() UserDTO` converters.
+	//
+	// Behavior:
+	//   - "fallback" (default): the receiver is the input only when no parameter is a struct.
+	//   - "prefer": a struct receiver is always the input; struct parameters become
+	//     additional inputs.
+	//
+	// Has no effect when include-methods is false.
+	// Default: "fallback"
+	ReceiverInput ReceiverInput `json:"receiver-input" mapstructure:"receiver-input"`
+
 	// AllowGetters allows Get* methods as a substitute for field access
 	// Default: true
 	AllowGetters bool `json:"allow-getters" mapstructure:"allow-getters"`
 
+	// SetterPatterns lists the method name patterns that write an output field: the
+	// output counterpart of getters, for types filled through methods (protobuf Opaque
+	// API messages, builders). Each pattern holds one "*" standing for the field name:
+	// "Set*" makes out.SetName(v) a write of Name, "Clear*" counts out.ClearName(), and
+	// "*" counts builder calls like b.Name(x). Calls chained off the output
+	// (b.SetName(x).SetEmail(y)) count as well.
+	//
+	// The smart fixer emits setter calls (out.SetName(in.Name)) for output fields that
+	// have a one-argument setter.
+	//
+	// Default: ["Set*", "Clear*"]
+	SetterPatterns []string `json:"setter-patterns" mapstructure:"setter-patterns"`
+
+	// CopyFunctions lists the functions that copy one struct into another by reflection,
+	// as "<import path>.<name>". A converter handing its input and output to one of them
+	// (copier.Copy(&out, &in), mapstructure.Decode(in, &out)) is judged by copy-handling
+	// instead of by the fields it touches.
+	//
+	// Default: ["github.com/jinzhu/copier.Copy", "github.com/jinzhu/copier.CopyWithOption",
+	// "github.com/mitchellh/mapstructure.Decode", "github.com/go-viper/mapstructure/v2.Decode"]
+	CopyFunctions []string `json:"copy-functions" mapstructure:"copy-functions"`
+
+	// CopyHandling specifies what a call to one of the copy-functions means.
+	//
+	// Behavior:
+	//   - "match" (default): fields are paired by name, case-insensitively, as the copy
+	//     libraries do; fields of either side without a counterpart are reported in an
+	//     "unchecked reflective copy" diagnostic.
+	//   - "trust": the copy covers every field of both sides.
+	//
+	// Default: "match"
+	CopyHandling CopyHandling `json:"copy-handling" mapstructure:"copy-handling"`
+
+	// NestedDepth sets how many levels below the converted types nested structs are
+	// checked field by field when the converter does not spell out a chain into them.
+	//
+	// A nested struct reached through an explicit chain (event.User.Role.Name) is always
+	// checked. Beyond that:
+	//   - 0 (default): a nested struct without a chain below it counts as handled.
+	//   - N > 0: a nested struct the converter builds or inspects piecewise within N levels
+	//     is checked too. Owner: &UserDTO{} reports Owner.ID, Owner.Name, ...; an input
+	//     only nil-checked (in.Owner != nil) reports what of it is never read.
+	//   - -1: as N, without a bound.
+	//
+	// A nested struct handled whole - assigned wholesale (User: in.User), passed to a
+	// converter or helper (Owner: ToUserDTO(in.Owner)) - counts as complete at any depth,
+	// and a self-referential type (Parent *Node) is expanded one level into itself.
+	//
+	// Default: 0
+	NestedDepth int `json:"nested-depth" mapstructure:"nested-depth"`
+
 	// AllowAggregators enables detection of slice->non-slice converters
 	// where the output struct contains a field that holds the converted slice.
 	// Default: true
@@ -115,6 +223,58 @@ type Config struct {
 	// Default: []
 	OnlyConverterPatterns []string `json:"only-converters" mapstructure:"only-converters"`
 
+	// NameMatching specifies how input and output type names are paired.
+	//
+	// Behavior:
+	//   - "containment" (default): one name must contain the other, case-insensitively.
+	//     Example: User → UserDTO matches, but so does Order → BorderDTO, and
+	//     UserRecord → UserDTO does not.
+	//   - "tokens": names are split into CamelCase tokens ("PBUser" → PB, User), the
+	//     name-affixes are stripped from both ends, and one token sequence must contain
+	//     the other as a whole. UserRecord and UserDTO both normalize to User and match;
+	//     Order → BorderDTO does not, since Order is no token of it.
+	//
+	// min-similarity applies to the normalized names under "tokens".
+	// Default: "containment"
+	NameMatching NameMatching `json:"name-matching" mapstructure:"name-matching"`
+
+	// NameAffixes lists the prefixes and suffixes stripped from type names before they
+	// are compared by the "tokens" name-matching strategy. An affix is matched as whole
+	// CamelCase tokens, case-insensitively, at either end of a name; a name is never
+	// stripped to nothing. Has no effect under "containment".
+	// Default: ["DTO", "Model", "PB", "Response", "Entity", "Row", "Record"]
+	NameAffixes []string `json:"name-affixes" mapstructure:"name-affixes"`
+
+	// TypePairs lists input/output type pairs that are converters whatever their names,
+	// for pairs name matching cannot see (Account -> ProfileResponse, Order -> Invoice).
+	//
+	// Each entry is "<in>=<out>". A side is a type name, optionally qualified by its
+	// package: "Account", "domain.Account" (package name) or
+	// "github.com/acme/shop/domain.Account" (import path, or its trailing elements:
+	// "shop/domain.Account"). Both the package and the type name may be globs
+	// ("*/api.*Response"); * does not cross a /.
+	//
+	// A function converting a listed pair is analyzed regardless of type-name matching
+	// and min-similarity. The structural requirements still apply.
+	// Example: ["domain.Account=api.ProfileResponse", "Order=Invoice"]
+	// Default: []
+	TypePairs []string `json:"type-pairs" mapstructure:"type-pairs"`
+
+	// PackagePairs restricts converter detection to input/output types from the listed
+	// package pairs, so incidental name overlap inside one layer (Message ->
+	// MessageNewParams) stops being taken for a conversion across layers.
+	//
+	// Each entry is "<in>=<out>", both sides glob patterns matched against the import path
+	// of the candidate types, or any trailing elements of it: "*/domain=*/dto" pairs
+	// "github.com/acme/shop/internal/domain" with ".../internal/dto". * does not cross a /.
+	//
+	// When set, a pair must come from a listed package pair in addition to matching by
+	// name (and min-similarity). Type pairs and //lostfield:converter directives are not
+	// restricted.
+	// Example: ["*/db=*/domain", "*/domain=*/api"]
+	// Default: [] (no restriction)
+	PackagePairs []string `json:"package-pairs" mapstructure:"package-pairs"`
+
 	// ExcludeFilePatterns is a list of glob patterns for file paths to exclude from analysis.
 	// Supports wildcards: * matches any sequence of characters, ? matches a single character.
 	// Patterns are matched against the full file path.
@@ -145,9 +305,9 @@ type Config struct {
 	// Deprecated fields are identified by "Deprecated:" in their documentation comments.
 	// By default they are excluded from validation (a converter may legitimately skip them).
 	//
-	// Note (Experimental): detection only works for fields whose source is part of the
-	// current analysis pass. Fields from third-party or out-of-analysis packages
-	// (e.g. generated files excluded from the run) cannot be identified as deprecated.
+	// Fields of imported types are recognized too: the package declaring them marks
+	// them with an analysis fact, generated and excluded files included (protoc's
+	// "Deprecated: Marked as deprecated in x.proto." counts).
 	//
 	// Default: false (deprecated fields are ignored)
 	IncludeDeprecated bool `json:"include-deprecated" mapstructure:"include-deprecated"`
@@ -210,6 +370,62 @@ type Config struct {
 	// Default: "strict"
 	FieldValidationMode FieldValidationMode `json:"field-validation-mode" mapstructure:"field-validation-mode"`
 
+	// PathSensitive checks output completeness per return statement.
+	//
+	// Behavior:
+	//   - false (default): Output fields set anywhere in the converter count, so a converter
+	//     returning a full UserDTO{...} on one branch and a half-filled one on another passes.
+	//
+	//   - true: Every return that yields a non-zero output must have all required fields set
+	//     on its own path through the control-flow graph; each offending return is reported.
+	//     Error paths (return UserDTO{}, err; return nil, err) are exempt.
+	//
+	// Default: false
+	PathSensitive bool `json:"path-sensitive" mapstructure:"path-sensitive"`
+
+	// Dataflow checks where field values come from and go to, on the SSA form of each
+	// converter (the analyzer then requires buildssa).
+	//
+	// Behavior:
+	//   - false (default): A field counts as used wherever it appears, so log.Print(in.Email)
+	//     covers the input field and out.Email = "" the output one.
+	//
+	//   - true: An output field set only from constants or zero values, and an input field
+	//     whose value reaches no output field, are reported too. Values are followed through
+	//     local temporaries and helper calls; a branch on an input field (other than a nil
+	//     check) counts for the values it chooses.
+	//
+	// Default: false
+	Dataflow bool `json:"dataflow" mapstructure:"dataflow"`
+
+	// SuspiciousMappings checks that each output field taken straight from an input field
+	// (Email: in.Name, out.Email = in.Name) takes the one that corresponds to it: the
+	// input field of the same name, or one sharing its name in a json, yaml, xml, db,
+	// bson or mapstructure tag.
+	//
+	// Behavior:
+	//   - false (default): Only whether fields are used is checked.
+	//
+	//   - true: Two output fields taking each other's input field (FirstName: in.LastName,
+	//     LastName: in.FirstName), and an output field taken from another input field while
+	//     its own is left unused, are reported as separate "suspicious mapping" diagnostics.
+	//
+	// Default: false
+	SuspiciousMappings bool `json:"suspicious-mappings" mapstructure:"suspicious-mappings"`
+
+	// OverwrittenFields checks that no output field is assigned twice on the same path
+	// with the first value never used (Status: "new" in the literal, then
+	// out.Status = in.Status).
+	//
+	// Behavior:
+	//   - false (default): Repeated assignments to an output field are not checked.
+	//
+	//   - true: Each assignment whose value is overwritten on every path before being
+	//     used is reported as a separate "assigned more than once" diagnostic.
+	//
+	// Default: false
+	OverwrittenFields bool `json:"overwritten-fields" mapstructure:"overwritten-fields"`
+
 	// FixMode controls whether diagnostics carry SuggestedFixes for automatic fixing.
 	// When combined with the -fix flag (from unitchecker), fixes are applied automatically.
 	//
@@ -226,15 +442,32 @@ type Config struct {
 	FixMode FixMode `json:"fix-mode" mapstructure:"fix-mode"`
 }
 
+// defaultCopyFunctions are the reflective copiers recognized out of the box.
+var defaultCopyFunctions = []string{
+	"github.com/jinzhu/copier.Copy",
+	"github.com/jinzhu/copier.CopyWithOption",
+	"github.com/mitchellh/mapstructure.Decode",
+	"github.com/go-viper/mapstructure/v2.Decode",
+}
+
 // DefaultConfig returns the default configuration.
 func DefaultConfig() Config {
 	return Config{
 		AllowMethodConverters:         true,
+		ReceiverInput:                 ReceiverFallback, // Receiver is the input of receiver-only methods
 		AllowGetters:                  true,
+		SetterPatterns:                []string{"Set*", "Clear*"},
+		CopyFunctions:                 slices.Clone(defaultCopyFunctions),
+		CopyHandling:                  CopyHandlingMatch, // Copies are checked by field name
 		AllowAggregators:              true,
+		NestedDepth:                   0, // Only explicit chains into nested structs are checked
 		ExcludeFieldPatterns:          []string{},
 		ExcludeConverterPatterns:      []string{},
 		OnlyConverterPatterns:         []string{},
+		NameMatching:                  NameMatchingContainment, // Raw name containment by default
+		NameAffixes:                   []string{"DTO", "Model", "PB", "Response", "Entity", "Row", "Record"},
+		TypePairs:                     []string{},
+		PackagePairs:                  []string{},
 		ExcludeFilePatterns:           []string{"*_test.go", "*.pb.go", "*/vendor/*"},
 		MinTypeNameSimilarity:         0.0, // 0 = use substring matching.
 		IgnoreFieldTags:               []string{},
@@ -245,6 +478,10 @@ func DefaultConfig() Config {
 		NonMarshallableFieldsHandling: HandleAdaptive,  // Adapt to what's present in both input and output models by default
 		IncludePrivateFields:          false,           // Ignore private fields by default
 		FieldValidationMode:           ModeStrict,      // Validate all fields by default
+		PathSensitive:                 false,           // Output fields from all paths merged by default
+		Dataflow:                      false,           // A field counts as used wherever it appears by default
+		SuspiciousMappings:            false,           // Which input field an output field takes is not checked by default
+		OverwrittenFields:             false,           // Repeated assignments to an output field are not checked by default
 		FixMode:                       FixModeDisabled, // Fix generation disabled by default
 	}
 }
@@ -268,6 +505,24 @@ func (c *Config) Validate() error {
 			c.FieldValidationMode)
 	}
 
+	switch c.ReceiverInput {
+	case ReceiverFallback, ReceiverPrefer:
+	default:
+		return fmt.Errorf("invalid receiver-input value %q (supported: fallback, prefer)", c.ReceiverInput)
+	}
+
+	switch c.CopyHandling {
+	case CopyHandlingMatch, CopyHandlingTrust:
+	default:
+		return fmt.Errorf("invalid copy-handling value %q (supported: match, trust)", c.CopyHandling)
+	}
+
+	switch c.NameMatching {
+	case NameMatchingContainment, NameMatchingTokens:
+	default:
+		return fmt.Errorf("invalid name-matching value %q (supported: containment, tokens)", c.NameMatching)
+	}
+
 	switch c.Format {
 	case FormatDefault, FormatPretty:
 	default:
@@ -284,15 +539,93 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("invalid min-similarity value %v (must be within 0.0-1.0)", c.MinTypeNameSimilarity)
 	}
 
+	if c.NestedDepth < -1 {
+		return fmt.Errorf("invalid nested-depth value %d (must be -1 or more)", c.NestedDepth)
+	}
+
 	for _, p := range c.ExcludeFieldPatterns {
 		if _, err := regexp.Compile(p); err != nil {
 			return fmt.Errorf("invalid exclude-fields pattern %q: %w", p, err)
 		}
 	}
 
+	for _, p := range c.SetterPatterns {
+		if _, _, err := SplitSetterPattern(p); err != nil {
+			return err
+		}
+	}
+
+	for _, f := range c.CopyFunctions {
+		if err := checkCopyFunction(f); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range c.TypePairs {
+		if _, _, err := SplitTypePair(p); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range c.PackagePairs {
+		if _, _, err := SplitPackagePair(p); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// SplitSetterPattern splits a setter-patterns entry around its "*" into the method name
+// prefix and suffix ("Set*" -> "Set", ""), checking that it holds exactly one "*" and
+// no other glob syntax.
+func SplitSetterPattern(pattern string) (string, string, error) {
+	prefix, suffix, ok := strings.Cut(pattern, "*")
+	if !ok || strings.ContainsAny(prefix+suffix, "*?[]\\") {
+		return "", "", fmt.Errorf(
+			"invalid setter-patterns entry %q (expected one * standing for the field name, e.g. Set*)", pattern)
+	}
+	return prefix, suffix, nil
+}
+
+// checkCopyFunction checks that a copy-functions entry is a qualified function name:
+// an import path, a dot and a name.
+func checkCopyFunction(name string) error {
+	dot := strings.LastIndex(name, ".")
+	if dot <= strings.LastIndex(name, "/") || dot == len(name)-1 {
+		return fmt.Errorf(
+			"invalid copy-functions entry %q (expected <import path>.<name>, e.g. github.com/jinzhu/copier.Copy)", name)
+	}
+	return nil
+}
+
+// SplitTypePair splits a type-pairs entry into its input and output type patterns,
+// checking that both are present and are valid globs.
+func SplitTypePair(pair string) (string, string, error) {
+	return splitPair("type-pairs", "domain.Account=api.ProfileResponse", pair)
+}
+
+// SplitPackagePair splits a package-pairs entry into its input and output package
+// patterns, checking that both are present and are valid globs.
+func SplitPackagePair(pair string) (string, string, error) {
+	return splitPair("package-pairs", "*/domain=*/dto", pair)
+}
+
+// splitPair splits an "<in>=<out>" entry of the named pair setting.
+func splitPair(setting, example, pair string) (string, string, error) {
+	in, out, ok := strings.Cut(pair, "=")
+	in, out = strings.TrimSpace(in), strings.TrimSpace(out)
+	if !ok || in == "" || out == "" || strings.Contains(out, "=") {
+		return "", "", fmt.Errorf("invalid %s entry %q (expected <in>=<out>, e.g. %s)", setting, pair, example)
+	}
+	for _, side := range []string{in, out} {
+		if _, err := path.Match(side, ""); err != nil {
+			return "", "", fmt.Errorf("invalid %s entry %q: %w", setting, pair, err)
+		}
+	}
+	return in, out, nil
+}
+
 // splitCommaSeparated splits a comma-separated string into a slice of strings.
 // Returns an empty slice if the input string is empty.
 func splitCommaSeparated(s string) []string {
@@ -310,9 +643,69 @@ func RegisterFlags(fs *flag.FlagSet, cfg *Config) {
 	fs.BoolVar(&cfg.AllowMethodConverters, "include-methods", cfg.AllowMethodConverters,
 		"check method receivers in addition to plain functions")
 
+	fs.Func(
+		"receiver-input",
+		"when a method's receiver is the converter input (fallback: only without struct parameters, prefer: always)",
+		func(s string) error {
+			cfg.ReceiverInput = ReceiverInput(s)
+			switch cfg.ReceiverInput {
+			case ReceiverFallback, ReceiverPrefer:
+				return nil
+			default:
+				return fmt.Errorf("invalid receiver-input value %q (supported: fallback, prefer)", s)
+			}
+		},
+	)
+
 	fs.BoolVar(&cfg.AllowGetters, "allow-getters", cfg.AllowGetters,
 		"allow Get* methods as a substitute for direct field access")
 
+	fs.Func(
+		"setter-patterns",
+		"comma-separated method name patterns that write an output field, * standing for the field name "+
+			"(default: 'Set*,Clear*')",
+		func(s string) error {
+			patterns := splitCommaSeparated(s)
+			for _, p := range patterns {
+				if _, _, err := SplitSetterPattern(p); err != nil {
+					return err
+				}
+			}
+			cfg.SetterPatterns = patterns
+			return nil
+		},
+	)
+
+	fs.Func(
+		"copy-functions",
+		"comma-separated <import path>.<name> of functions copying structs by reflection "+
+			"(default: copier.Copy, copier.CopyWithOption, mapstructure.Decode)",
+		func(s string) error {
+			names := splitCommaSeparated(s)
+			for _, f := range names {
+				if err := checkCopyFunction(f); err != nil {
+					return err
+				}
+			}
+			cfg.CopyFunctions = names
+			return nil
+		},
+	)
+
+	fs.Func(
+		"copy-handling",
+		"what a copy-functions call means (match: fields paired by name, the unmatched reported; trust: full coverage)",
+		func(s string) error {
+			cfg.CopyHandling = CopyHandling(s)
+			switch cfg.CopyHandling {
+			case CopyHandlingMatch, CopyHandlingTrust:
+				return nil
+			default:
+				return fmt.Errorf("invalid copy-handling value %q (supported: match, trust)", s)
+			}
+		},
+	)
+
 	fs.BoolVar(&cfg.AllowAggregators, "allow-aggregators", cfg.AllowAggregators,
 		"enable detection of slice->non-slice aggregating converters")
 
@@ -349,6 +742,62 @@ func RegisterFlags(fs *flag.FlagSet, cfg *Config) {
 		},
 	)
 
+	fs.Func(
+		"name-matching",
+		"how input and output type names are paired (containment: one contains the other, "+
+			"tokens: CamelCase tokens with name-affixes stripped)",
+		func(s string) error {
+			cfg.NameMatching = NameMatching(s)
+			switch cfg.NameMatching {
+			case NameMatchingContainment, NameMatchingTokens:
+				return nil
+			default:
+				return fmt.Errorf("invalid name-matching value %q (supported: containment, tokens)", s)
+			}
+		},
+	)
+
+	fs.Func(
+		"name-affixes",
+		"comma-separated type name prefixes/suffixes stripped by -name-matching=tokens (e.g., 'DTO,Model,PB')",
+		func(s string) error {
+			cfg.NameAffixes = splitCommaSeparated(s)
+			return nil
+		},
+	)
+
+	fs.Func(
+		"type-pairs",
+		"comma-separated input=output type pairs analyzed as converters regardless of their names "+
+			"(e.g., 'domain.Account=api.ProfileResponse,Order=Invoice')",
+		func(s string) error {
+			pairs := splitCommaSeparated(s)
+			for _, p := range pairs {
+				if _, _, err := SplitTypePair(p); err != nil {
+					return err
+				}
+			}
+			cfg.TypePairs = pairs
+			return nil
+		},
+	)
+
+	fs.Func(
+		"package-pairs",
+		"comma-separated input=output import-path globs converter types must come from "+
+			"(e.g., '*/domain=*/dto,*/db=*/domain')",
+		func(s string) error {
+			pairs := splitCommaSeparated(s)
+			for _, p := range pairs {
+				if _, _, err := SplitPackagePair(p); err != nil {
+					return err
+				}
+			}
+			cfg.PackagePairs = pairs
+			return nil
+		},
+	)
+
 	fs.Func(
 		"exclude-files",
 		"comma-separated glob patterns for file paths to exclude from analysis (e.g., '*_test.go,*.pb.go,*/vendor/*')",
@@ -374,6 +823,22 @@ func RegisterFlags(fs *flag.FlagSet, cfg *Config) {
 		},
 	)
 
+	fs.Func(
+		"nested-depth",
+		"levels of nested structs to check field by field without an explicit chain (0=chains only, -1=unlimited)",
+		func(s string) error {
+			v, err := strconv.Atoi(s)
+			if err != nil {
+				return fmt.Errorf("invalid nested-depth value %q: %w", s, err)
+			}
+			if v < -1 {
+				return fmt.Errorf("invalid nested-depth value %q (must be -1 or more)", s)
+			}
+			cfg.NestedDepth = v
+			return nil
+		},
+	)
+
 	fs.Func("ignore-tags", "comma-separated struct tags to ignore fields (e.g., 'lostfield:\"ignore\"')",
 		func(s string) error {
 			cfg.IgnoreFieldTags = splitCommaSeparated(s)
@@ -434,6 +899,18 @@ func RegisterFlags(fs *flag.FlagSet, cfg *Config) {
 		},
 	)
 
+	fs.BoolVar(&cfg.PathSensitive, "path-sensitive", cfg.PathSensitive,
+		"require every non-error return to set all output fields on its own path (default: merge all paths)")
+
+	fs.BoolVar(&cfg.Dataflow, "dataflow", cfg.Dataflow,
+		"require output field values to derive from the input, and input field values to reach the output (uses SSA)")
+
+	fs.BoolVar(&cfg.SuspiciousMappings, "suspicious-mappings", cfg.SuspiciousMappings,
+		"report output fields taken from another input field than their own: swapped pairs, or one left unused")
+
+	fs.BoolVar(&cfg.OverwrittenFields, "overwritten-fields", cfg.OverwrittenFields,
+		"report output fields assigned again on the same path before their first value is used")
+
 	fs.Func(
 		"fix-mode",
 		"fix mode for automatic fixes (empty=disabled, safe=suppress warnings, smart=infer mappings)",
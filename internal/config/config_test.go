@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"flag"
+	"slices"
 	"strings"
 	"testing"
 
@@ -24,6 +25,14 @@ func TestDefaultConfig(t *testing.T) {
 		t.Errorf("AllowAggregators: got %v, want true", cfg.AllowAggregators)
 	}
 
+	if cfg.NestedDepth != 0 {
+		t.Errorf("NestedDepth: got %d, want 0", cfg.NestedDepth)
+	}
+
+	if cfg.ReceiverInput != config.ReceiverFallback {
+		t.Errorf("ReceiverInput: got %q, want %q", cfg.ReceiverInput, config.ReceiverFallback)
+	}
+
 	if cfg.Verbose != false {
 		t.Errorf("Verbose: got %v, want false", cfg.Verbose)
 	}
@@ -36,6 +45,22 @@ func TestDefaultConfig(t *testing.T) {
 		t.Errorf("IncludeDeprecated: got %v, want false", cfg.IncludeDeprecated)
 	}
 
+	if cfg.PathSensitive != false {
+		t.Errorf("PathSensitive: got %v, want false", cfg.PathSensitive)
+	}
+
+	if cfg.Dataflow != false {
+		t.Errorf("Dataflow: got %v, want false", cfg.Dataflow)
+	}
+
+	if cfg.SuspiciousMappings != false {
+		t.Errorf("SuspiciousMappings: got %v, want false", cfg.SuspiciousMappings)
+	}
+
+	if cfg.OverwrittenFields != false {
+		t.Errorf("OverwrittenFields: got %v, want false", cfg.OverwrittenFields)
+	}
+
 	// Verify non-boolean defaults
 	if len(cfg.ExcludeFieldPatterns) > 0 {
 		t.Errorf("ExcludeFieldPatterns: got %q, want empty string", cfg.ExcludeFieldPatterns)
@@ -45,6 +70,36 @@ func TestDefaultConfig(t *testing.T) {
 		t.Errorf("ExcludeConverterPatterns: got %q, want empty string", cfg.ExcludeConverterPatterns)
 	}
 
+	if cfg.NameMatching != config.NameMatchingContainment {
+		t.Errorf("NameMatching: got %q, want %q", cfg.NameMatching, config.NameMatchingContainment)
+	}
+
+	wantAffixes := "DTO,Model,PB,Response,Entity,Row,Record"
+	if strings.Join(cfg.NameAffixes, ",") != wantAffixes {
+		t.Errorf("NameAffixes: got %q, want %q", cfg.NameAffixes, wantAffixes)
+	}
+
+	wantSetters := "Set*,Clear*"
+	if strings.Join(cfg.SetterPatterns, ",") != wantSetters {
+		t.Errorf("SetterPatterns: got %q, want %q", cfg.SetterPatterns, wantSetters)
+	}
+
+	if cfg.CopyHandling != config.CopyHandlingMatch {
+		t.Errorf("CopyHandling: got %q, want %q", cfg.CopyHandling, config.CopyHandlingMatch)
+	}
+
+	if !slices.Contains(cfg.CopyFunctions, "github.com/jinzhu/copier.Copy") {
+		t.Errorf("CopyFunctions: got %q, want copier.Copy among them", cfg.CopyFunctions)
+	}
+
+	if len(cfg.TypePairs) > 0 {
+		t.Errorf("TypePairs: got %q, want empty", cfg.TypePairs)
+	}
+
+	if len(cfg.PackagePairs) > 0 {
+		t.Errorf("PackagePairs: got %q, want empty", cfg.PackagePairs)
+	}
+
 	// Verify ExcludeFilePatterns has expected defaults
 	expectedFilePatterns := []string{"*_test.go", "*.pb.go", "*/vendor/*"}
 	if len(cfg.ExcludeFilePatterns) != len(expectedFilePatterns) {
@@ -88,6 +143,16 @@ func TestRegisterFlags(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:     "receiver-input flag",
+			flagName: "-receiver-input",
+			value:    "prefer",
+			checkFunc: func(t *testing.T, cfg *config.Config) {
+				if cfg.ReceiverInput != config.ReceiverPrefer {
+					t.Errorf("ReceiverInput: got %q, want %q", cfg.ReceiverInput, config.ReceiverPrefer)
+				}
+			},
+		},
 		{
 			name:     "allow-getters flag",
 			flagName: "-allow-getters",
@@ -98,6 +163,38 @@ func TestRegisterFlags(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:     "setter-patterns flag",
+			flagName: "-setter-patterns",
+			value:    "Set*,With*",
+			checkFunc: func(t *testing.T, cfg *config.Config) {
+				want := "Set*,With*"
+				if strings.Join(cfg.SetterPatterns, ",") != want {
+					t.Errorf("SetterPatterns: got %q, want %q", cfg.SetterPatterns, want)
+				}
+			},
+		},
+		{
+			name:     "copy-functions flag",
+			flagName: "-copy-functions",
+			value:    "example.com/clone.Into,github.com/jinzhu/copier.Copy",
+			checkFunc: func(t *testing.T, cfg *config.Config) {
+				want := "example.com/clone.Into,github.com/jinzhu/copier.Copy"
+				if strings.Join(cfg.CopyFunctions, ",") != want {
+					t.Errorf("CopyFunctions: got %q, want %q", cfg.CopyFunctions, want)
+				}
+			},
+		},
+		{
+			name:     "copy-handling flag",
+			flagName: "-copy-handling",
+			value:    "trust",
+			checkFunc: func(t *testing.T, cfg *config.Config) {
+				if cfg.CopyHandling != config.CopyHandlingTrust {
+					t.Errorf("CopyHandling: got %q, want %q", cfg.CopyHandling, config.CopyHandlingTrust)
+				}
+			},
+		},
 		{
 			name:     "allow-aggregators flag",
 			flagName: "-allow-aggregators",
@@ -130,6 +227,89 @@ func TestRegisterFlags(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:     "path-sensitive flag",
+			flagName: "-path-sensitive",
+			value:    "true",
+			checkFunc: func(t *testing.T, cfg *config.Config) {
+				if !cfg.PathSensitive {
+					t.Errorf("PathSensitive: got false, want true")
+				}
+			},
+		},
+		{
+			name:     "dataflow flag",
+			flagName: "-dataflow",
+			value:    "true",
+			checkFunc: func(t *testing.T, cfg *config.Config) {
+				if !cfg.Dataflow {
+					t.Errorf("Dataflow: got false, want true")
+				}
+			},
+		},
+		{
+			name:     "suspicious-mappings flag",
+			flagName: "-suspicious-mappings",
+			value:    "true",
+			checkFunc: func(t *testing.T, cfg *config.Config) {
+				if !cfg.SuspiciousMappings {
+					t.Errorf("SuspiciousMappings: got false, want true")
+				}
+			},
+		},
+		{
+			name:     "overwritten-fields flag",
+			flagName: "-overwritten-fields",
+			value:    "true",
+			checkFunc: func(t *testing.T, cfg *config.Config) {
+				if !cfg.OverwrittenFields {
+					t.Errorf("OverwrittenFields: got false, want true")
+				}
+			},
+		},
+		{
+			name:     "name-matching flag",
+			flagName: "-name-matching",
+			value:    "tokens",
+			checkFunc: func(t *testing.T, cfg *config.Config) {
+				if cfg.NameMatching != config.NameMatchingTokens {
+					t.Errorf("NameMatching: got %q, want %q", cfg.NameMatching, config.NameMatchingTokens)
+				}
+			},
+		},
+		{
+			name:     "name-affixes flag",
+			flagName: "-name-affixes",
+			value:    "DTO,Proto",
+			checkFunc: func(t *testing.T, cfg *config.Config) {
+				want := "DTO,Proto"
+				if strings.Join(cfg.NameAffixes, ",") != want {
+					t.Errorf("NameAffixes: got %q, want %q", cfg.NameAffixes, want)
+				}
+			},
+		},
+		{
+			name:     "type-pairs flag",
+			flagName: "-type-pairs",
+			value:    "domain.Account=api.ProfileResponse,Order=Invoice",
+			checkFunc: func(t *testing.T, cfg *config.Config) {
+				want := "domain.Account=api.ProfileResponse,Order=Invoice"
+				if strings.Join(cfg.TypePairs, ",") != want {
+					t.Errorf("TypePairs: got %q, want %q", cfg.TypePairs, want)
+				}
+			},
+		},
+		{
+			name:     "package-pairs flag",
+			flagName: "-package-pairs",
+			value:    "*/db=*/domain,*/domain=*/api",
+			checkFunc: func(t *testing.T, cfg *config.Config) {
+				want := "*/db=*/domain,*/domain=*/api"
+				if strings.Join(cfg.PackagePairs, ",") != want {
+					t.Errorf("PackagePairs: got %q, want %q", cfg.PackagePairs, want)
+				}
+			},
+		},
 		{
 			name:     "exclude-files flag",
 			flagName: "-exclude-files",
@@ -152,6 +332,16 @@ func TestRegisterFlags(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:     "nested-depth flag",
+			flagName: "-nested-depth",
+			value:    "-1",
+			checkFunc: func(t *testing.T, cfg *config.Config) {
+				if cfg.NestedDepth != -1 {
+					t.Errorf("NestedDepth: got %d, want -1", cfg.NestedDepth)
+				}
+			},
+		},
 		{
 			name:     "ignore-tags flag",
 			flagName: "-ignore-tags",
@@ -169,6 +359,18 @@ func TestRegisterFlags(t *testing.T) {
 			value:    "1.5",
 			wantErr:  true,
 		},
+		{
+			name:     "nested-depth below -1",
+			flagName: "-nested-depth",
+			value:    "-2",
+			wantErr:  true,
+		},
+		{
+			name:     "nested-depth not a number",
+			flagName: "-nested-depth",
+			value:    "deep",
+			wantErr:  true,
+		},
 		{
 			name:     "invalid format",
 			flagName: "-format",
@@ -187,6 +389,48 @@ func TestRegisterFlags(t *testing.T) {
 			value:    "union",
 			wantErr:  true,
 		},
+		{
+			name:     "invalid receiver-input",
+			flagName: "-receiver-input",
+			value:    "always",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid name-matching",
+			flagName: "-name-matching",
+			value:    "fuzzy",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid type-pairs",
+			flagName: "-type-pairs",
+			value:    "Account",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid setter-patterns",
+			flagName: "-setter-patterns",
+			value:    "Set",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid copy-functions",
+			flagName: "-copy-functions",
+			value:    "copier",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid copy-handling",
+			flagName: "-copy-handling",
+			value:    "ignore",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid package-pairs",
+			flagName: "-package-pairs",
+			value:    "*/domain",
+			wantErr:  true,
+		},
 		{
 			name:     "invalid fix-mode",
 			flagName: "-fix-mode",
@@ -33,6 +33,21 @@ func TestConfigValidate(t *testing.T) {
 				mutate:  func(c *config.Config) { c.FieldValidationMode = "union" },
 				wantErr: `invalid field-validation-mode value "union"`,
 			},
+			{
+				name:    "receiver-input",
+				mutate:  func(c *config.Config) { c.ReceiverInput = "always" },
+				wantErr: `invalid receiver-input value "always"`,
+			},
+			{
+				name:    "name-matching",
+				mutate:  func(c *config.Config) { c.NameMatching = "fuzzy" },
+				wantErr: `invalid name-matching value "fuzzy"`,
+			},
+			{
+				name:    "copy-handling",
+				mutate:  func(c *config.Config) { c.CopyHandling = "ignore" },
+				wantErr: `invalid copy-handling value "ignore"`,
+			},
 			{
 				name:    "format",
 				mutate:  func(c *config.Config) { c.Format = "fancy" },
@@ -71,6 +86,16 @@ func TestConfigValidate(t *testing.T) {
 		g.Expect(cfg.Validate()).To(MatchError(be_string.ContainingSubstring("min-similarity")))
 	})
 
+	t.Run("nested-depth below -1 is rejected", func(t *testing.T) {
+		g := NewWithT(t)
+		cfg := config.DefaultConfig()
+		cfg.NestedDepth = -1
+		g.Expect(cfg.Validate()).To(Succeed())
+
+		cfg.NestedDepth = -2
+		g.Expect(cfg.Validate()).To(MatchError(be_string.ContainingSubstring("nested-depth")))
+	})
+
 	t.Run("invalid exclude-fields regex is rejected", func(t *testing.T) {
 		g := NewWithT(t)
 		cfg := config.DefaultConfig()
@@ -80,4 +105,57 @@ func TestConfigValidate(t *testing.T) {
 		g.Expect(err).To(HaveOccurred())
 		g.Expect(err.Error()).To(be_string.ContainingSubstring(`invalid exclude-fields pattern "[unclosed"`))
 	})
+
+	t.Run("malformed type-pairs entries are rejected", func(t *testing.T) {
+		for _, pair := range []string{"Account", "=api.ProfileResponse", "Account=", "A=B=C", "domain.[Account=api.Profile"} {
+			g := NewWithT(t)
+			cfg := config.DefaultConfig()
+			cfg.TypePairs = []string{"Order=Invoice", pair}
+
+			err := cfg.Validate()
+			g.Expect(err).To(HaveOccurred(), pair)
+			g.Expect(err.Error()).To(be_string.ContainingSubstring(`invalid type-pairs entry`))
+		}
+	})
+
+	t.Run("setter-patterns entries need exactly one *", func(t *testing.T) {
+		for _, pattern := range []string{"Set", "Set**", "*Set*", "Set[A-Z]*", "Set?*"} {
+			g := NewWithT(t)
+			cfg := config.DefaultConfig()
+			cfg.SetterPatterns = []string{"With*", pattern}
+
+			err := cfg.Validate()
+			g.Expect(err).To(HaveOccurred(), pattern)
+			g.Expect(err.Error()).To(be_string.ContainingSubstring(`invalid setter-patterns entry`))
+		}
+
+		g := NewWithT(t)
+		cfg := config.DefaultConfig()
+		cfg.SetterPatterns = []string{"*", "With*", "*Set"}
+		g.Expect(cfg.Validate()).To(Succeed())
+	})
+
+	t.Run("copy-functions entries need an import path and a name", func(t *testing.T) {
+		for _, name := range []string{"copier", "github.com/jinzhu/copier", "copier.", "github.com/jinzhu/copier.Copy/x"} {
+			g := NewWithT(t)
+			cfg := config.DefaultConfig()
+			cfg.CopyFunctions = []string{"example.com/clone.Into", name}
+
+			err := cfg.Validate()
+			g.Expect(err).To(HaveOccurred(), name)
+			g.Expect(err.Error()).To(be_string.ContainingSubstring(`invalid copy-functions entry`))
+		}
+	})
+
+	t.Run("malformed package-pairs entries are rejected", func(t *testing.T) {
+		for _, pair := range []string{"*/domain", "=*/dto", "*/domain=", "[db=*/domain"} {
+			g := NewWithT(t)
+			cfg := config.DefaultConfig()
+			cfg.PackagePairs = []string{"*/db=*/domain", pair}
+
+			err := cfg.Validate()
+			g.Expect(err).To(HaveOccurred(), pair)
+			g.Expect(err.Error()).To(be_string.ContainingSubstring(`invalid package-pairs entry`))
+		}
+	})
 }
@@ -0,0 +1,190 @@
+package lf
+
+import (
+	"go/ast"
+	"go/token"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// omittedCommentPattern matches the in-literal acknowledgement "// Email: intentionally omitted"
+// (any text may follow). The captured path is relative to the literal the comment sits in.
+var omittedCommentPattern = regexp.MustCompile(`^//\s*([A-Za-z_]\w*(?:\.[A-Za-z_]\w*)*)\s*:\s*intentionally omitted\b`)
+
+// acknowledgements holds the field paths a converter marks as deliberately not mapped,
+// per side. Paths are relative to the candidate struct, like UsageLookup keys.
+type acknowledgements struct {
+	in   UsageLookup            // bare paths: apply to every input
+	vars map[string]UsageLookup // paths prefixed with an input variable
+	out  UsageLookup
+}
+
+// input returns the acknowledged paths of the input read through any of vars.
+func (a acknowledgements) input(vars ...string) UsageLookup {
+	res := make(UsageLookup, len(a.in))
+	maps.Copy(res, a.in)
+	for _, v := range vars {
+		maps.Copy(res, a.vars[v])
+	}
+	return res
+}
+
+// collectAcknowledgements gathers the field-level acknowledgements of fn:
+//
+//   - "//lostfield:skip Email, User.Role.Name -- reason" anywhere in fn (doc comment
+//     included). A path prefixed with an input or the output variable ("in.Email",
+//     "out.Email") applies to that side only; a bare path applies to both.
+//   - "// Email: intentionally omitted" inside a composite literal building the output
+//     (outCandName). It applies to the output side, relative to the literal it sits in,
+//     so a comment inside the nested Role literal of User acknowledges "User.Role.<Field>".
+//
+// Both replace the `_ = in.Field` stub statements that used to be the only way to say so.
+// inVars lists every name an input is read through (parameters and loop variables).
+func collectAcknowledgements(
+	fn *ast.FuncDecl,
+	pass *analysis.Pass,
+	inVars []string,
+	outVar, outCandName string,
+) acknowledgements {
+	acks := acknowledgements{
+		in:   make(UsageLookup),
+		vars: make(map[string]UsageLookup),
+		out:  make(UsageLookup),
+	}
+
+	file := fileOf(pass, fn.Pos())
+	if file == nil {
+		return acks
+	}
+
+	start := fn.Pos()
+	if fn.Doc != nil {
+		start = fn.Doc.Pos()
+	}
+
+	spans := outputLitSpans(fn, outCandName)
+
+	for _, cg := range file.Comments {
+		if cg.End() < start || cg.Pos() > fn.End() {
+			continue
+		}
+		for _, c := range cg.List {
+			if d, ok := parseDirective(c); ok && d.name == directiveSkip {
+				for _, path := range skipPaths(d.args) {
+					head, rest, nested := strings.Cut(path, ".")
+					switch {
+					case nested && slices.Contains(inVars, head):
+						if acks.vars[head] == nil {
+							acks.vars[head] = make(UsageLookup)
+						}
+						acks.vars[head].Add(rest)
+					case nested && outVar != "" && head == outVar:
+						acks.out.Add(rest)
+					default:
+						acks.in.Add(path)
+						acks.out.Add(path)
+					}
+				}
+				continue
+			}
+
+			m := omittedCommentPattern.FindStringSubmatch(c.Text)
+			if m == nil {
+				continue
+			}
+			if prefix, ok := innermostSpan(spans, c.Slash); ok {
+				acks.out.Add(joinPath(prefix, m[1]))
+			}
+		}
+	}
+
+	return acks
+}
+
+// skipPaths splits the arguments of a //lostfield:skip directive into field paths.
+// Paths may be separated by commas, spaces, or both.
+func skipPaths(args string) []string {
+	return strings.FieldsFunc(args, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+}
+
+// litSpan is the brace range of an output composite literal and the field path it builds.
+type litSpan struct {
+	lbrace, rbrace token.Pos
+	prefix         string
+}
+
+// outputLitSpans returns the spans of every output composite literal in fn, including
+// the literals nested in their keyed values.
+func outputLitSpans(fn *ast.FuncDecl, candidateName string) []litSpan {
+	if candidateName == "" {
+		return nil
+	}
+	var spans []litSpan
+	var walk func(cl *ast.CompositeLit, prefix string)
+	walk = func(cl *ast.CompositeLit, prefix string) {
+		spans = append(spans, litSpan{lbrace: cl.Lbrace, rbrace: cl.Rbrace, prefix: prefix})
+		for _, elt := range cl.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			key, ok := kv.Key.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			value := kv.Value
+			if paren, isParen := value.(*ast.ParenExpr); isParen {
+				value = paren.X
+			}
+			if nested := unwrapCompositeLit(value); nested != nil {
+				walk(nested, joinPath(prefix, key.Name))
+			}
+		}
+	}
+	for _, cl := range outputCompositeLits(fn, candidateName) {
+		walk(cl, "")
+	}
+	return spans
+}
+
+// innermostSpan returns the prefix of the innermost span enclosing pos.
+func innermostSpan(spans []litSpan, pos token.Pos) (string, bool) {
+	var best *litSpan
+	for i := range spans {
+		sp := &spans[i]
+		if pos <= sp.lbrace || pos >= sp.rbrace {
+			continue
+		}
+		if best == nil || sp.lbrace > best.lbrace {
+			best = sp
+		}
+	}
+	if best == nil {
+		return "", false
+	}
+	return best.prefix, true
+}
+
+// joinPath joins a field path prefix and a field name with a dot, tolerating an empty prefix.
+func joinPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+// fileOf returns the file of the pass that contains pos.
+func fileOf(pass *analysis.Pass, pos token.Pos) *ast.File {
+	for _, f := range pass.Files {
+		if f.FileStart <= pos && pos <= f.FileEnd {
+			return f
+		}
+	}
+	return nil
+}
@@ -10,9 +10,9 @@ import (
 	"regexp"
 	"slices"
 	"strings"
-	"sync"
 
 	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/buildssa"
 
 	"github.com/amberpixels/lostfield/internal/config"
 	"github.com/amberpixels/lostfield/internal/lf/fixer"
@@ -66,6 +66,7 @@ type pendingDiagnostic struct {
 	filename   string
 	fn         *ast.FuncDecl
 	validation *ConverterValidationResult
+	ret        *ast.ReturnStmt // the offending return, for a path-sensitive finding
 }
 
 // NewAnalyzer builds the lostfield analysis.Analyzer bound to the given configuration.
@@ -77,13 +78,19 @@ func NewAnalyzer(cfg *config.Config) *analysis.Analyzer {
 		def := config.DefaultConfig()
 		cfg = &def
 	}
-	return &analysis.Analyzer{
+	a := &analysis.Analyzer{
 		Name: config.LinterName,
 		Doc:  config.LinterDoc,
 		Run: func(pass *analysis.Pass) (any, error) {
 			return Run(pass, cfg)
 		},
+		FactTypes: []analysis.Fact{new(FieldUsageFact), new(DeprecatedFieldFact), new(IncompleteConverterFact)},
 	}
+	// Only dataflow mode pays for building SSA.
+	if cfg.Dataflow {
+		a.Requires = []*analysis.Analyzer{buildssa.Analyzer}
+	}
+	return a
 }
 
 // Run executes the analysis for a single package with the given configuration.
@@ -93,35 +100,62 @@ func Run(pass *analysis.Pass, cfg *config.Config) (any, error) {
 
 	// Collect all diagnostics first so we can number them.
 	var pending []pendingDiagnostic
+	// Directive problems (unused or unknown directives) are reported after the
+	// converter findings and are not numbered: they are about comments, not converters.
+	var directiveReports []analysis.Diagnostic
+	var fieldReports []analysis.Diagnostic
+
+	// Deprecated fields are known before any converter is validated against them.
+	exportDeprecatedFieldFacts(pass)
+
+	// Helper summaries are shared by every converter of the package.
+	helpers := newHelperSummaries(pass, cfg)
 
 	for _, file := range pass.Files {
 		// Get the filename from the file position.
 		filename := pass.Fset.Position(file.Pos()).Filename
 
-		// Skip files matching exclusion patterns (e.g., test files, vendor, proto-generated).
-		if len(cfg.ExcludeFilePatterns) > 0 && MatchesAnyPattern(filename, cfg.ExcludeFilePatterns) {
-			continue
-		}
-
-		// Skip other generated files if configured (checks AST comments).
-		if !cfg.IncludeGenerated && isGeneratedFile(file) {
+		if isFileExcluded(file, filename, cfg) {
 			continue
 		}
 
 		filesTotal++
 
-		// Walk the AST and look for function declarations.
+		fileIgnore, hasFileIgnore := fileIgnoreDirective(file)
+		fileIgnoreUsed := false
+
+		// Function literals are analyzed like declarations, under a synthesized name.
+		lits := literalDecls(file, pass.TypesInfo)
+
+		// Walk the AST and look for function declarations and literals.
 		ast.Inspect(file, func(n ast.Node) bool {
-			fn, ok := n.(*ast.FuncDecl)
-			if !ok {
+			var fn *ast.FuncDecl
+			switch node := n.(type) {
+			case *ast.FuncDecl:
+				fn = node
+			case *ast.FuncLit:
+				fn = lits[node]
+			default:
 				return true
 			}
 
+			dirs := parseFuncDirectives(fn)
+
 			if !IsPossibleConverter(fn, pass, cfg) {
+				if dirs.converter != nil && !isExcludedByConfig(fn, cfg) {
+					directiveReports = append(directiveReports, analysis.Diagnostic{
+						Pos: dirs.converter.pos,
+						Message: fmt.Sprintf("%s: //lostfield:converter directive has no effect: "+
+							"no struct input and output to compare", fn.Name.Name),
+					})
+				}
+				if dirs.ignore != nil && !isExcludedByConfig(fn, cfg) {
+					directiveReports = append(directiveReports, unusedIgnoreDiagnostic(fn, dirs.ignore))
+				}
 				return true
 			}
 
-			validationResult, err := ValidateConverter(fn, pass, cfg)
+			validationResult, err := helpers.validate(fn)
 			if err != nil {
 				// Not a validation failure but an inability to validate (e.g. unnamed
 				// candidate parameter). Skip the function; report only in verbose mode.
@@ -131,20 +165,84 @@ func Run(pass *analysis.Pass, cfg *config.Config) (any, error) {
 				return true
 			}
 
-			if validationResult.Valid {
+			if validationResult.Valid && len(validationResult.ReturnPaths) == 0 &&
+				len(validationResult.SuspiciousMappings)+len(validationResult.OverwrittenFields) == 0 {
+				if dirs.ignore != nil {
+					directiveReports = append(directiveReports, unusedIgnoreDiagnostic(fn, dirs.ignore))
+				}
 				return true
 			}
 
-			pending = append(pending, pendingDiagnostic{
-				pos:        fn.Name.Pos(),
-				filename:   filename,
-				fn:         fn,
-				validation: validationResult,
-			})
+			// A finding exists: honor the ignore directives, function-level first.
+			if dirs.ignore != nil || hasFileIgnore {
+				fileIgnoreUsed = fileIgnoreUsed || dirs.ignore == nil
+				if cfg.Verbose {
+					d := dirs.ignore
+					if d == nil {
+						d = &fileIgnore
+					}
+					fmt.Fprintf(os.Stderr, "lostfield: skipping %s: //lostfield:%s %s\n",
+						fn.Name.Name, d.name, d.reasonText())
+				}
+				return true
+			}
+
+			// Converters of other packages filling a field with this one learn it is incomplete.
+			if validationResult.hasMissingFields() {
+				if obj, ok := pass.TypesInfo.Defs[fn.Name].(*types.Func); ok && obj.Exported() {
+					pass.ExportObjectFact(obj, &IncompleteConverterFact{Missing: validationResult.missingFields()})
+				}
+			}
+
+			if !validationResult.Valid {
+				pending = append(pending, pendingDiagnostic{
+					pos:        fn.Name.Pos(),
+					filename:   filename,
+					fn:         fn,
+					validation: validationResult,
+				})
+			}
+			for _, rp := range validationResult.ReturnPaths {
+				pending = append(pending, pendingDiagnostic{
+					pos:      rp.Return.Pos(),
+					filename: filename,
+					fn:       fn,
+					validation: &ConverterValidationResult{
+						ConverterType:       validationResult.ConverterType,
+						MissingOutputFields: rp.Missing,
+					},
+					ret: rp.Return,
+				})
+			}
+			// Suspicious mappings and overwritten fields are separate findings, about single
+			// fields rather than the converter, and are not numbered with it.
+			for _, m := range validationResult.SuspiciousMappings {
+				fieldReports = append(fieldReports, analysis.Diagnostic{
+					Pos:     m.Pos,
+					Message: m.message(fn.Name.Name),
+				})
+			}
+			for _, o := range validationResult.OverwrittenFields {
+				fieldReports = append(fieldReports, o.diagnostic(fn.Name.Name))
+			}
 			filesWarned[filename] = struct{}{}
 
 			return true
 		})
+
+		if hasFileIgnore && !fileIgnoreUsed {
+			directiveReports = append(directiveReports, analysis.Diagnostic{
+				Pos:     fileIgnore.pos,
+				Message: "unused //lostfield:file-ignore directive: no converter in this file has lost fields",
+			})
+		}
+
+		for _, d := range unknownDirectives(file) {
+			directiveReports = append(directiveReports, analysis.Diagnostic{
+				Pos:     d.pos,
+				Message: fmt.Sprintf("unknown directive //lostfield:%s", d.name),
+			})
+		}
 	}
 
 	// Format and report all diagnostics with numbering.
@@ -154,15 +252,20 @@ func Run(pass *analysis.Pass, cfg *config.Config) (any, error) {
 		formattedMessage := fmtr.Format(&formatter.FormatContext{
 			Filename: d.filename,
 			Fn:       d.fn,
+			Return:   d.ret,
 			Pass:     pass,
 			Verbose:  cfg.Verbose,
 			Index:    i + 1,
 			Total:    total,
 			Validation: &formatter.ConverterValidationResult{
-				Valid:               d.validation.Valid,
-				ConverterType:       string(d.validation.ConverterType),
-				MissingInputFields:  d.validation.MissingInputFields,
-				MissingOutputFields: d.validation.MissingOutputFields,
+				Valid:                d.validation.Valid,
+				ConverterType:        string(d.validation.ConverterType),
+				MissingInputFields:   d.validation.MissingInputFields,
+				MissingOutputFields:  d.validation.MissingOutputFields,
+				CopyCall:             d.validation.CopyCall,
+				NestedCalls:          formatNestedCalls(d.validation.NestedCalls),
+				LostInputFields:      d.validation.LostInputFields,
+				ConstantOutputFields: d.validation.ConstantOutputFields,
 			},
 		})
 
@@ -170,7 +273,7 @@ func Run(pass *analysis.Pass, cfg *config.Config) (any, error) {
 		if d.validation.Fix != nil && cfg.FixMode != config.FixModeDisabled {
 			suggestedFixes = fixer.GenerateFixes(d.validation.Fix, &fixer.ValidationResult{
 				MissingInputFields:  d.validation.MissingInputFields,
-				MissingOutputFields: d.validation.MissingOutputFields,
+				MissingOutputFields: d.validation.fixOutputFields(),
 			}, string(cfg.FixMode))
 		}
 
@@ -178,9 +281,19 @@ func Run(pass *analysis.Pass, cfg *config.Config) (any, error) {
 			Pos:            d.pos,
 			Message:        formattedMessage,
 			SuggestedFixes: suggestedFixes,
+			Related:        nestedCallsRelated(d.validation.NestedCalls),
 		})
 	}
 
+	for _, d := range fieldReports {
+		pass.Report(d)
+	}
+	for _, d := range directiveReports {
+		pass.Report(d)
+	}
+
+	exportFieldUsageFacts(pass, helpers)
+
 	// At the end of processing all files, print the total number of warnings.
 	// Only print if verbose mode is enabled.
 	if cfg.Verbose {
@@ -201,6 +314,25 @@ func Run(pass *analysis.Pass, cfg *config.Config) (any, error) {
 	return nil, nil //nolint:nilnil // analyzer does not produce results for downstream analyzers
 }
 
+// isFileExcluded reports whether the configuration leaves file out of the analysis: it
+// matches exclude-files (test files, vendor, proto-generated), or it is generated code
+// (checked through its comments) and include-generated is off.
+func isFileExcluded(file *ast.File, filename string, cfg *config.Config) bool {
+	if len(cfg.ExcludeFilePatterns) > 0 && MatchesAnyPattern(filename, cfg.ExcludeFilePatterns) {
+		return true
+	}
+	return !cfg.IncludeGenerated && isGeneratedFile(file)
+}
+
+// unusedIgnoreDiagnostic reports a //lostfield:ignore directive on a function that has
+// nothing to suppress, so stale directives get cleaned up instead of hiding the next bug.
+func unusedIgnoreDiagnostic(fn *ast.FuncDecl, d *directive) analysis.Diagnostic {
+	return analysis.Diagnostic{
+		Pos:     d.pos,
+		Message: fmt.Sprintf("%s: unused //lostfield:ignore directive: no lost fields to suppress", fn.Name.Name),
+	}
+}
+
 // ContainerType represents the "container" kind for a candidate type.
 type ContainerType string
 
@@ -214,9 +346,11 @@ const (
 // candidate holds the underlying candidate type's name and its container type.
 type candidate struct {
 	name          string
+	matchName     string // name used to pair input and output types, see candidateMatchName
 	containerType ContainerType
 	structType    *types.Struct
 	fullType      types.Type // Full type info for accurate comparisons
+	typeParam     bool       // the candidate is a type parameter with a core struct type
 }
 
 // extractCandidateType checks if the given type qualifies as a candidate for conversion.
@@ -252,7 +386,28 @@ func extractCandidateType(t types.Type) (candidate, bool) {
 		t = ptr.Elem()
 	}
 
+	// A type parameter stands for its core struct type: func Convert[T Entity](in T)
+	// converts whatever struct Entity admits.
+	if tp, okTP := t.(*types.TypeParam); okTP {
+		core := coreType(tp)
+		if core == nil {
+			return candidate{}, false
+		}
+		st, okStruct := core.Underlying().(*types.Struct)
+		if !okStruct {
+			return candidate{}, false
+		}
+		cand.name = tp.Obj().Name()
+		cand.matchName = candidateMatchName(tp)
+		cand.structType = st
+		cand.fullType = tp
+		cand.typeParam = true
+		return cand, true
+	}
+
 	// We expect a named type whose underlying type is a struct.
+	// For an instantiated generic type (Page[User]) the underlying struct already has
+	// the type arguments substituted, so fields are checked against the instantiation.
 	named, okNamed := t.(*types.Named)
 	if !okNamed {
 		return candidate{}, false
@@ -262,11 +417,84 @@ func extractCandidateType(t types.Type) (candidate, bool) {
 		return candidate{}, false
 	}
 	cand.name = named.Obj().Name()
+	cand.matchName = candidateMatchName(named)
 	cand.structType = st
 	cand.fullType = named // Store the full type for accurate comparison
 	return cand, true
 }
 
+// coreType returns the single type every type in tp's type set shares (by underlying
+// type), or nil when the constraint admits several distinct types or any type at all.
+// For "[T interface{ User }]", "[T ~struct{...}]" or "[T User]" that is the struct.
+func coreType(tp *types.TypeParam) types.Type {
+	iface, ok := tp.Constraint().Underlying().(*types.Interface)
+	if !ok {
+		return nil
+	}
+	var core types.Type
+	for _, term := range typeTerms(iface) {
+		if core == nil {
+			core = term
+			continue
+		}
+		if !types.Identical(core.Underlying(), term.Underlying()) {
+			return nil
+		}
+	}
+	return core
+}
+
+// typeTerms returns the type terms of a constraint interface, following embedded
+// constraints. Method-only interfaces contribute no terms.
+func typeTerms(iface *types.Interface) []types.Type {
+	var terms []types.Type
+	for emb := range iface.EmbeddedTypes() {
+		switch e := emb.(type) {
+		case *types.Union:
+			for term := range e.Terms() {
+				terms = append(terms, term.Type())
+			}
+		default:
+			if inner, isIface := e.Underlying().(*types.Interface); isIface {
+				terms = append(terms, typeTerms(inner)...)
+				continue
+			}
+			terms = append(terms, e)
+		}
+	}
+	return terms
+}
+
+// candidateMatchName returns the name a candidate is paired by. It is the type name
+// followed by the names of its type arguments, so Page[User] matches Page[UserDTO]
+// ("PageUser" / "PageUserDTO") but not Page[Order]. A type parameter goes by the name
+// of its core type; one without a named core type adds nothing, so the fully generic
+// Page[T] -> Page[U] still pairs on "Page".
+func candidateMatchName(t types.Type) string {
+	switch tt := t.(type) {
+	case *types.Pointer:
+		return candidateMatchName(tt.Elem())
+	case *types.Slice:
+		return candidateMatchName(tt.Elem())
+	case *types.Array:
+		return candidateMatchName(tt.Elem())
+	case *types.Map:
+		return candidateMatchName(tt.Elem())
+	case *types.TypeParam:
+		if core, ok := coreType(tt).(*types.Named); ok {
+			return candidateMatchName(core)
+		}
+		return ""
+	case *types.Named:
+		name := tt.Obj().Name()
+		for arg := range tt.TypeArgs().Types() {
+			name += candidateMatchName(arg)
+		}
+		return name
+	}
+	return ""
+}
+
 // isConstructor checks if a function is a constructor.
 // A constructor is a function that:
 //   - Starts with "New"
@@ -280,122 +508,144 @@ func isConstructor(fn *ast.FuncDecl) bool {
 //   - At least one input and one output candidate exist.
 //   - Candidate is the argument who fits the candidate type (struct or pointer to struct).
 //   - For at least one candidate pair (input, output) with the same container type,
-//     the names of the candidate types share a common substring (ignoring case).
+//     the names of the candidate types match (see typeNamesMatch): by default, one
+//     contains the other (ignoring case).
 //
 // Constructors (functions starting with "New") are excluded.
+//
+// A //lostfield:converter directive in the doc comment lifts the heuristic checks:
+// the constructor rule and the type-name matching. The configured name filters and the
+// structural requirements (a struct candidate on both sides) still apply.
+// A pair listed in the type-pairs setting lifts the type-name matching alone. The
+// package-pairs setting, when set, restricts every other pair to the listed packages.
 func IsPossibleConverter(fn *ast.FuncDecl, pass *analysis.Pass, cfg *config.Config) bool {
-	// Exclude constructors (functions starting with "New")
-	if isConstructor(fn) {
+	// Declarations without a body (assembly, linkname) convert nothing we can see.
+	if fn.Body == nil {
 		return false
 	}
 
-	// Check if the function name matches any exclusion patterns
-	if len(cfg.ExcludeConverterPatterns) > 0 && MatchesAnyPattern(fn.Name.Name, cfg.ExcludeConverterPatterns) {
-		return false
-	}
-
-	// If only-converters is set, reject functions that don't match any pattern
-	if len(cfg.OnlyConverterPatterns) > 0 && !MatchesAnyPattern(fn.Name.Name, cfg.OnlyConverterPatterns) {
-		return false
-	}
+	forced := isForcedConverter(fn)
 
-	// If we're not including methods and this function has a receiver, skip it.
-	if !cfg.AllowMethodConverters && fn.Recv != nil {
+	// Exclude constructors (functions starting with "New")
+	if isConstructor(fn) && !forced {
 		return false
 	}
 
-	obj := pass.TypesInfo.Defs[fn.Name]
-	if obj == nil {
+	if isExcludedByConfig(fn, cfg) {
 		return false
 	}
 
-	sig, ok := obj.Type().(*types.Signature)
+	sig, ok := funcSignature(fn, pass)
 	if !ok {
 		return false
 	}
 
-	// No arguments: nothing was converted
-	if sig.Params().Len() == 0 || sig.Results().Len() == 0 {
-		return false
-	}
+	inputs, outputs := converterSides(pass.TypesInfo, fn, sig, cfg)
 
-	// Gather candidate types from input parameters.
+	// Gather candidate types from input parameters (and the receiver, see converterInputs).
 	var inCandidates []candidate
-	for param := range sig.Params().Variables() {
-		if cand, ok := extractCandidateType(param.Type()); ok {
-			inCandidates = append(inCandidates, cand)
-		}
+	for _, in := range inputs {
+		inCandidates = append(inCandidates, in.cand)
 	}
 	if len(inCandidates) == 0 {
 		return false
 	}
 
-	// Gather candidate types from output parameters.
+	// Gather candidate types from output parameters (or targets, see converterSides).
+	// No output: nothing was converted.
 	var outCandidates []candidate
-	for res := range sig.Results().Variables() {
-		if cand, ok := extractCandidateType(res.Type()); ok {
-			outCandidates = append(outCandidates, cand)
-		}
+	for _, out := range outputs {
+		outCandidates = append(outCandidates, out.cand)
 	}
 	if len(outCandidates) == 0 {
 		return false
 	}
 
-	// Look for at least one candidate pair (in, out) where:
-	// - The container types are compatible:
-	//    - if the input candidate is a slice or map, then the output candidate must be of the same container type.
-	//    - otherwise, if the input candidate is a plain struct or pointer to struct, the output candidate
-	//      must also be a plain struct or pointer (i.e. not a slice or map).
-	// - The candidate names are different (no same-type conversions like DB -> DB)
-	// - And the candidate names share a common substring (ignoring case).
 	for _, inCand := range inCandidates {
 		for _, outCand := range outCandidates {
-			// Exclude same-type conversions (e.g., *DB -> *DB is not a converter)
-			// Compare full types, not just names, to avoid false positives like models.MatchedMapData -> pbVenueConfig.MatchedMapData
-			if inCand.fullType != nil && outCand.fullType != nil && types.Identical(inCand.fullType, outCand.fullType) {
-				continue
+			if isConverterPair(inCand, outCand, cfg, forced) {
+				return true
 			}
+		}
+	}
 
-			// Check container type compatibility.
-			if inCand.containerType == ContainerSlice || inCand.containerType == ContainerMap {
-				if inCand.containerType != outCand.containerType {
-					// Special case: slice input to non-slice output may be an aggregating converter
-					if cfg.AllowAggregators && inCand.containerType == ContainerSlice {
-						if isAgg, _ := isAggregatingConverter(inCand, outCand); isAgg {
-							// For aggregating converters, we consider them as converters based on
-							// the presence of a slice field in the output, regardless of name similarity.
-							// The actual field validation will determine if it's a valid converter.
-							return true
-						}
-					}
-					continue // e.g. slice -> non-slice is not allowed (unless aggregating).
-				}
-			} else {
-				// inCand is ContainerNone or ContainerPointer.
-				// Allow output to be either a plain struct or a pointer.
-				if outCand.containerType != ContainerNone && outCand.containerType != ContainerPointer {
-					continue
-				}
-			}
+	return false
+}
 
-			// Match type names. Containment is the gate; min-similarity is a floor on
-			// top of it, never an alternative to it. Keeping it that way is what makes
-			// the setting monotonic: raising it can only ever narrow the set of pairs.
-			// The two are not otherwise comparable - a bigram score alone happily
-			// matches ImportLocationOptions to ImportLocationResult on their shared
-			// prefix, which containment is right to reject.
-			if !nameContainment(inCand.name, outCand.name) {
-				continue
-			}
-			if cfg.MinTypeNameSimilarity > 0 &&
-				typeNameSimilarity(inCand.name, outCand.name) < cfg.MinTypeNameSimilarity {
-				continue
+// isConverterPair reports whether converting inCand to outCand makes a converter: the
+// container types are compatible (a slice or map input needs the same container on the
+// output, unless it aggregates; a struct or pointer input needs a struct or pointer
+// output), the types differ (no same-type conversions like DB -> DB), and the names
+// match (see typeNamesMatch). A forced pair (//lostfield:converter) needs no name match.
+func isConverterPair(inCand, outCand candidate, cfg *config.Config, forced bool) bool {
+	// Exclude same-type conversions (e.g., *DB -> *DB is not a converter)
+	// Compare full types, not just names, to avoid false positives like models.MatchedMapData -> pbVenueConfig.MatchedMapData
+	if inCand.fullType != nil && outCand.fullType != nil && types.Identical(inCand.fullType, outCand.fullType) {
+		return false
+	}
+
+	// A forced converter or a configured type pair needs no name match, and is not
+	// restricted by package-pairs.
+	explicit := forced || matchesTypePair(inCand, outCand, cfg)
+
+	// Check container type compatibility.
+	if inCand.containerType == ContainerSlice || inCand.containerType == ContainerMap {
+		if inCand.containerType != outCand.containerType {
+			// Special case: slice input to non-slice output may be an aggregating converter
+			if cfg.AllowAggregators && inCand.containerType == ContainerSlice {
+				isAgg, _ := isAggregatingConverter(inCand, outCand)
+				// For aggregating converters, we consider them as converters based on
+				// the presence of a slice field in the output, regardless of name similarity.
+				// The actual field validation will determine if it's a valid converter.
+				return isAgg && (explicit || matchesPackagePairs(inCand, outCand, cfg))
 			}
-			return true
+			return false // e.g. slice -> non-slice is not allowed (unless aggregating).
 		}
+	} else if outCand.containerType != ContainerNone && outCand.containerType != ContainerPointer {
+		// inCand is ContainerNone or ContainerPointer.
+		// Allow output to be either a plain struct or a pointer.
+		return false
 	}
 
-	return false
+	if explicit {
+		return true
+	}
+	if !matchesPackagePairs(inCand, outCand, cfg) {
+		return false
+	}
+
+	// Match type names (see typeNamesMatch).
+	return typeNamesMatch(inCand.matchName, outCand.matchName, cfg)
+}
+
+// funcSignature returns the signature of fn. A declaration synthesized for a function
+// literal (see literalDecls) has no object of its own; its signature is the literal's type.
+func funcSignature(fn *ast.FuncDecl, pass *analysis.Pass) (*types.Signature, bool) {
+	var t types.Type
+	if obj := pass.TypesInfo.Defs[fn.Name]; obj != nil {
+		t = obj.Type()
+	} else {
+		t = pass.TypesInfo.TypeOf(fn.Type)
+	}
+	sig, ok := t.(*types.Signature)
+	return sig, ok
+}
+
+// isExcludedByConfig reports whether the configuration rules fn out by name or kind
+// (exclude-converters, only-converters, include-methods), before its signature is looked at.
+func isExcludedByConfig(fn *ast.FuncDecl, cfg *config.Config) bool {
+	// Check if the function name matches any exclusion patterns
+	if len(cfg.ExcludeConverterPatterns) > 0 && MatchesAnyPattern(fn.Name.Name, cfg.ExcludeConverterPatterns) {
+		return true
+	}
+
+	// If only-converters is set, reject functions that don't match any pattern
+	if len(cfg.OnlyConverterPatterns) > 0 && !MatchesAnyPattern(fn.Name.Name, cfg.OnlyConverterPatterns) {
+		return true
+	}
+
+	// If we're not including methods and this function has a receiver, skip it.
+	return !cfg.AllowMethodConverters && fn.Recv != nil
 }
 
 // nameContainment reports whether one type name contains the other, case-insensitively.
@@ -441,75 +691,30 @@ func isNonMarshallableType(fieldType types.Type) bool {
 	return false
 }
 
-// deprecatedFieldCache memoizes the per-file index of deprecated struct field names.
-// Keyed by filename (NOT *ast.File - a pointer key would pin every visited AST in
-// memory for the process lifetime, which matters in long golangci-lint runs);
-// values are map[string]bool. The maps are tiny (field names only) and computed
-// at most once per file regardless of how many fields are validated.
-var deprecatedFieldCache sync.Map
-
-// deprecatedFieldsInFile walks a file's AST once and returns the set of struct field
-// names whose doc comment contains a "Deprecated:" marker.
-func deprecatedFieldsInFile(filename string, file *ast.File) map[string]bool {
-	if cached, ok := deprecatedFieldCache.Load(filename); ok {
-		if m, isMap := cached.(map[string]bool); isMap {
-			return m
-		}
-	}
-
-	deprecated := make(map[string]bool)
-	ast.Inspect(file, func(n ast.Node) bool {
-		structType, ok := n.(*ast.StructType)
-		if !ok || structType.Fields == nil {
-			return true
-		}
-		for _, f := range structType.Fields.List {
-			if f.Doc == nil {
-				continue
-			}
-			for _, comment := range f.Doc.List {
-				if strings.Contains(comment.Text, "Deprecated:") {
-					for _, name := range f.Names {
-						deprecated[name.Name] = true
-					}
-					break
-				}
-			}
-		}
-		return true
-	})
-
-	deprecatedFieldCache.Store(filename, deprecated)
-	return deprecated
-}
-
-// isDeprecatedField checks if a field is marked as deprecated by looking for
-// "Deprecated:" in its documentation comment.
-//
-// Limitation: only fields whose defining file is part of the current analysis pass
-// can be identified as deprecated. Fields of types imported from other packages
-// (including generated .pb.go files excluded from the run) are never considered
-// deprecated, because their ASTs (and doc comments) are not available here.
+// isDeprecatedField checks if a field is marked as deprecated: its documentation
+// comment contains "Deprecated:". Fields of this package and of imported ones are both
+// known, through the DeprecatedFieldFact exported where the field is declared. Fields of
+// generic types are looked up on their origin.
 func isDeprecatedField(field *types.Var, pass *analysis.Pass) bool {
-	if field == nil || pass == nil {
-		return false
-	}
-
-	fieldPos := field.Pos()
-	if !fieldPos.IsValid() {
+	if field == nil || pass == nil || pass.ImportObjectFact == nil {
 		return false
 	}
+	return pass.ImportObjectFact(field.Origin(), new(DeprecatedFieldFact))
+}
 
-	filename := pass.Fset.Position(fieldPos).Filename
-
-	for _, file := range pass.Files {
-		if pass.Fset.Position(file.Pos()).Filename != filename {
-			continue
-		}
-		return deprecatedFieldsInFile(filename, file)[field.Name()]
-	}
-
-	return false
+// fieldUsage is everything known about how a converter handles the fields of one side.
+type fieldUsage struct {
+	// fields holds the field chains accessed on the variable (e.g. "User.Role.Name").
+	fields UsageLookup
+	// methods holds the methods called on the variable; getters among them stand in
+	// for field reads. Nil on the output side and for nested structs.
+	methods UsageLookup
+	// acknowledged holds the field paths the converter explicitly marks as not mapped
+	// (//lostfield:skip, or a "// Field: intentionally omitted" comment).
+	acknowledged UsageLookup
+	// opened holds the nested struct paths the converter handles piecewise (see
+	// collectOpenedFields): within nested-depth they are checked field by field.
+	opened UsageLookup
 }
 
 // collectMissingFields is similar to checkAllFieldsUsed but returns a slice of missing field names.
@@ -518,24 +723,24 @@ func isDeprecatedField(field *types.Var, pass *analysis.Pass) bool {
 // It now supports nested field tracking (e.g., "User.Role.Name").
 func collectMissingFields(
 	st *types.Struct,
-	usedFields UsageLookup,
+	usage fieldUsage,
 	pass *analysis.Pass,
 	cfg *config.Config,
-	usedMethodsArg ...UsageLookup,
 ) []string {
-	return collectMissingFieldsWithPrefix(st, usedFields, pass, cfg, "", usedMethodsArg...)
+	return collectMissingFieldsWithPrefix(st, usage, pass, cfg, "", nestedScope{path: []*types.Struct{st}})
 }
 
 // collectMissingFieldsWithPrefix recursively collects missing fields for a struct, tracking the nesting prefix.
 // For example, when validating nested structs, prefix might be "User.Role" to track User.Role.Name, User.Role.ID, etc.
 func collectMissingFieldsWithPrefix(
 	st *types.Struct,
-	usedFields UsageLookup,
+	usage fieldUsage,
 	pass *analysis.Pass,
 	cfg *config.Config,
 	prefix string,
-	usedMethodsArg ...UsageLookup,
+	scope nestedScope,
 ) []string {
+	usedFields := usage.fields
 	var missing []string
 	excludePatterns := compileFieldPatterns(cfg.ExcludeFieldPatterns)
 	for i := range st.NumFields() {
@@ -583,6 +788,23 @@ func collectMissingFieldsWithPrefix(
 			fullFieldName = prefix + "." + field.Name()
 		}
 
+		// A field the converter explicitly acknowledges is handled, and so is everything under it.
+		if usage.acknowledged.Has(fullFieldName) {
+			continue
+		}
+
+		// An embedded struct not handled whole is checked field by field, so the report
+		// names the promoted fields left out (GormModel.DeletedAt). They may be set through
+		// the embed (out.GormModel.ID) or promoted (out.ID); each goes through the filters
+		// above on its own. A type embedding a pointer to itself is not entered again.
+		if field.Embedded() && (!usedFields.Has(fullFieldName) || isFieldPartOfNestedChain(fullFieldName, usedFields)) {
+			if embedded := embeddedStruct(field); embedded != nil && scope.entered(embedded) == 0 {
+				missing = append(missing, collectMissingFieldsWithPrefix(embedded, promoteUsage(usage, prefix, fullFieldName),
+					pass, cfg, fullFieldName, scope.enter(embedded, true))...)
+				continue
+			}
+		}
+
 		// Check if field is used directly
 		fieldUsed := usedFields.Has(fullFieldName)
 
@@ -592,26 +814,17 @@ func collectMissingFieldsWithPrefix(
 			fieldUsed = isFieldPartOfNestedChain(fullFieldName, usedFields)
 		}
 
-		// For embedded structs, also check if the leaf fields are used through embedding
-		// E.g., if DbApple has embedded GormModel with field ID, and we do dbapple.ID = value,
-		// we should consider GormModel.ID as "used"
-		if !fieldUsed && field.Embedded() {
-			if embeddedStruct, ok := field.Type().Underlying().(*types.Struct); ok {
-				fieldUsed = areEmbeddedFieldsUsed(embeddedStruct, usedFields)
-			}
-		}
-
 		if !fieldUsed {
 			// if methods were given, let's allow via getters (if config allows)
 			// If a getter method exists (for input candidate) then allow it.
-			if cfg.AllowGetters && len(usedMethodsArg) > 0 && usedMethodsArg[0].Has("Get"+field.Name()) {
+			if cfg.AllowGetters && usage.methods.Has("Get"+field.Name()) {
 				continue
 			}
 			missing = append(missing, fullFieldName)
 		} else {
 			// Field is used - check if it's a struct field that needs nested validation
 			// Only validate nested fields if there are actually nested accesses in usedFields
-			nestedMissing := validateNestedStructField(field, fullFieldName, usedFields, pass, cfg)
+			nestedMissing := validateNestedStructField(field, fullFieldName, usage, pass, cfg, scope)
 			missing = append(missing, nestedMissing...)
 		}
 	}
@@ -619,15 +832,18 @@ func collectMissingFieldsWithPrefix(
 }
 
 // validateNestedStructField checks if all fields of a nested struct are properly used.
-// It only performs validation if the nested field has explicit nested field accesses in usedFields.
+// It only performs validation if the nested field has explicit nested field accesses in usedFields,
+// or, within nested-depth, when the converter handles the nested struct piecewise (usage.opened).
 func validateNestedStructField(
 	field *types.Var,
 	fieldPath string,
-	usedFields UsageLookup,
+	usage fieldUsage,
 	pass *analysis.Pass,
 	cfg *config.Config,
+	scope nestedScope,
 ) []string {
 	var missing []string
+	usedFields := usage.fields
 
 	// Get the field type, unwrapping pointers
 	fieldType := field.Type()
@@ -657,9 +873,17 @@ func validateNestedStructField(
 		}
 	}
 
+	// Without a chain, a nested struct built or nil-checked piecewise is validated within
+	// nested-depth; a self-referential type is entered once more at most (Manager, not
+	// Manager.Manager), so an unlimited depth still ends.
+	opened := usage.opened.Has(fieldPath) && scope.withinDepth(cfg) && scope.entered(nestedStruct) < 2
+
 	// Only validate nested fields if there are explicit nested accesses
-	if hasNestedAccess {
-		nestedMissing := collectMissingFieldsWithPrefix(nestedStruct, usedFields, pass, cfg, fieldPath)
+	if hasNestedAccess || opened {
+		// Getters are only known for the top-level struct, so nested levels go without.
+		nested := fieldUsage{fields: usedFields, acknowledged: usage.acknowledged, opened: usage.opened}
+		nestedMissing := collectMissingFieldsWithPrefix(nestedStruct, nested, pass, cfg, fieldPath,
+			scope.enter(nestedStruct, false))
 		missing = append(missing, nestedMissing...)
 	}
 
@@ -678,37 +902,42 @@ func isFieldPartOfNestedChain(fieldName string, usedFields UsageLookup) bool {
 	return false
 }
 
-// areEmbeddedFieldsUsed checks if all exported fields of an embedded struct are used.
-// Returns true ONLY if all exported fields of the embedded struct are found in usedFields.
-// This handles cases like: type DbApple { GormModel; ... } with dbapple.ID = value (where ID comes from GormModel).
-//
-// This function is strict: if you embed a struct, you must initialize ALL its exported fields,
-// either explicitly in a composite literal or by direct assignment.
-func areEmbeddedFieldsUsed(embeddedStruct *types.Struct, usedFields UsageLookup) bool {
-	// Collect all exported fields from the embedded struct
-	exportedFieldCount := 0
-	usedEmbeddedCount := 0
-
-	for field := range embeddedStruct.Fields() {
-		if !field.Exported() {
-			continue
-		}
-		exportedFieldCount++
+// embeddedStruct returns the struct an embedded field promotes fields from, seeing
+// through a pointer embed (*GormModel), or nil when it is no struct.
+func embeddedStruct(field *types.Var) *types.Struct {
+	t := field.Type()
+	if ptr, ok := types.Unalias(t).(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	st, _ := t.Underlying().(*types.Struct)
+	return st
+}
 
-		// Check if this field is in usedFields (it would be accessed as parent.fieldName due to embedding)
-		if usedFields.Has(field.Name()) {
-			usedEmbeddedCount++
+// promoteUsage returns usage with the fields used (acknowledged, opened) at the level of
+// from - "" for the variable itself - also recorded under embed, the path of a struct
+// embedded there: a promoted out.ID counts as out.GormModel.ID. Embeds nested in embeds
+// are promoted a level at a time.
+func promoteUsage(usage fieldUsage, from, embed string) fieldUsage {
+	promote := func(keys UsageLookup) UsageLookup {
+		res := maps.Clone(keys)
+		if res == nil {
+			return nil
+		}
+		for k := range keys {
+			if from == "" {
+				res.Add(embed + "." + k)
+			} else if rest, ok := strings.CutPrefix(k, from+"."); ok {
+				res.Add(embed + "." + rest)
+			}
 		}
+		return res
 	}
-
-	// If there are no exported fields, consider it "used"
-	if exportedFieldCount == 0 {
-		return true
+	return fieldUsage{
+		fields:       promote(usage.fields),
+		methods:      usage.methods,
+		acknowledged: promote(usage.acknowledged),
+		opened:       promote(usage.opened),
 	}
-
-	// Consider the embedded struct "used" ONLY if ALL its exported fields are used/set
-	// This ensures complete initialization of embedded structs
-	return usedEmbeddedCount == exportedFieldCount
 }
 
 // ConverterType indicates the type of converter function.
@@ -718,6 +947,8 @@ const (
 	ConverterTypeNormal      ConverterType = "converter"
 	ConverterTypeDelegating  ConverterType = "delegating converter"
 	ConverterTypeAggregating ConverterType = "aggregating converter"
+	ConverterTypeConversion  ConverterType = "type conversion"
+	ConverterTypeCopy        ConverterType = "reflective copy"
 )
 
 // ConverterValidationResult holds the details of a converter function validation.
@@ -731,11 +962,83 @@ type ConverterValidationResult struct {
 	// that were not used.
 	MissingInputFields []string
 	// MissingOutputFields contains the names of exported fields in the output candidate
-	// that were not used.
+	// that were not used. For a multi-output converter it lists every output in turn.
 	MissingOutputFields []string
+	// CopyCall names the reflective copy call (copier.Copy) of a ConverterTypeCopy
+	// converter; the missing fields are the ones it does not pair by name.
+	CopyCall string
+	// ReturnPaths lists, in path-sensitive mode, the return statements that leave output
+	// fields unset on their own path, beyond the fields missing from the converter as a
+	// whole. They are reported even when Valid is true.
+	ReturnPaths []ReturnFields
+	// LostInputFields lists, in dataflow mode, the input fields read whose values reach
+	// no output field. They make the result invalid like missing fields do.
+	LostInputFields []string
+	// ConstantOutputFields lists, in dataflow mode, the output fields set only from
+	// constants or zero values.
+	ConstantOutputFields []string
+	// NestedCalls lists the output fields filled by a nested converter call whose callee
+	// is an incomplete converter or not a converter at all. They explain the diagnostic of
+	// a converter reported for other reasons and leave Valid as it is: the callee's own
+	// findings are reported on the callee.
+	NestedCalls []NestedCall
+	// SuspiciousMappings lists, when enabled, the output fields taken from another input
+	// field than their own. They are reported on their own and leave Valid as it is.
+	SuspiciousMappings []SuspiciousMapping
+	// OverwrittenFields lists, when enabled, the output fields assigned again on a path where their
+	// previous value is never used. Like SuspiciousMappings, they are reported on their own.
+	OverwrittenFields []OverwrittenField
 	// Fix holds the context needed to generate suggested fixes.
 	// Nil when fix generation is not applicable (e.g., aggregating converters).
 	Fix *fixer.FixContext
+
+	// outputs breaks MissingOutputFields down per result of a multi-output converter (nil
+	// when there is a single output), for the suggested fixes (see fixOutputFields).
+	outputs []outputFields
+}
+
+// ReturnFields holds the output fields one return statement leaves unset on its path.
+type ReturnFields struct {
+	// Return is the offending return statement.
+	Return *ast.ReturnStmt
+	// Missing contains the (prefixed, like MissingOutputFields) names of the fields not set.
+	Missing []string
+}
+
+// outputFields holds the missing fields of one result of a multi-output converter.
+type outputFields struct {
+	// prefix qualifies the missing fields: the result name, or its type name when unnamed.
+	prefix string
+	// missing contains the qualified names of the fields that were not set.
+	missing []string
+}
+
+// hasMissingFields reports whether the converter leaves out fields of its own, apart
+// from the ones lost in its nested converter calls.
+func (r *ConverterValidationResult) hasMissingFields() bool {
+	return len(r.MissingInputFields)+len(r.MissingOutputFields) > 0
+}
+
+// missingFields returns the missing input fields, then the missing output fields.
+func (r *ConverterValidationResult) missingFields() []string {
+	return slices.Concat(r.MissingInputFields, r.MissingOutputFields)
+}
+
+// fixOutputFields returns the missing output fields suggested fixes work on. Fixes only
+// cover the first output, whose fields are passed without the prefix when it is a type name.
+func (r *ConverterValidationResult) fixOutputFields() []string {
+	if len(r.outputs) == 0 {
+		return r.MissingOutputFields
+	}
+	first := r.outputs[0]
+	if r.Fix != nil && first.prefix == r.Fix.OutVar {
+		return first.missing
+	}
+	fields := make([]string, len(first.missing))
+	for i, m := range first.missing {
+		fields[i] = strings.TrimPrefix(m, first.prefix+".")
+	}
+	return fields
 }
 
 func NewOKConverterValidationResult() *ConverterValidationResult {
@@ -748,28 +1051,34 @@ func NewFailedConverterValidationResult(in, out []string) *ConverterValidationRe
 
 // filterMissingFieldsByNonMarshallableMode filters missing fields based on the NonMarshallableFieldsHandling config.
 // For "both-or-nothing" mode, only keeps non-marshallable fields that exist in both input and output missing lists.
+// Multi-input and multi-output converters pass all their inputs and outputs: each side is
+// their union.
 func filterMissingFieldsByNonMarshallableMode(
 	inMissing, outMissing []string,
-	inStruct, outStruct *types.Struct,
+	inStructs, outStructs []*types.Struct,
 	cfg *config.Config,
 ) ([]string, []string) {
 	if cfg.NonMarshallableFieldsHandling != config.HandleAdaptive {
 		return inMissing, outMissing
 	}
 
-	// Build maps of field names to their types for both structs
+	// Build maps of field names to their types for both sides
 	inFieldTypes := make(map[string]types.Type)
 	outFieldTypes := make(map[string]types.Type)
 
-	for field := range inStruct.Fields() {
-		if field.Exported() {
-			inFieldTypes[field.Name()] = field.Type()
+	for _, inStruct := range inStructs {
+		for field := range inStruct.Fields() {
+			if _, seen := inFieldTypes[field.Name()]; !seen && field.Exported() {
+				inFieldTypes[field.Name()] = field.Type()
+			}
 		}
 	}
 
-	for field := range outStruct.Fields() {
-		if field.Exported() {
-			outFieldTypes[field.Name()] = field.Type()
+	for _, outStruct := range outStructs {
+		for field := range outStruct.Fields() {
+			if _, seen := outFieldTypes[field.Name()]; !seen && field.Exported() {
+				outFieldTypes[field.Name()] = field.Type()
+			}
 		}
 	}
 
@@ -825,28 +1134,34 @@ func filterMissingFieldsByNonMarshallableMode(
 
 // filterMissingFieldsByValidationMode filters missing fields based on the FieldValidationMode config.
 // For "intersection" mode, only keeps fields that exist in both input and output types.
+// Multi-input and multi-output converters pass all their inputs and outputs: a field is
+// expected when any struct on the other side has it.
 func filterMissingFieldsByValidationMode(
 	inMissing, outMissing []string,
-	inStruct, outStruct *types.Struct,
+	inStructs, outStructs []*types.Struct,
 	cfg *config.Config,
 ) ([]string, []string) {
 	if cfg.FieldValidationMode != config.ModeIntersection {
 		return inMissing, outMissing
 	}
 
-	// Build sets of field names for both structs
+	// Build sets of field names for both sides
 	inFieldNames := make(map[string]bool)
 	outFieldNames := make(map[string]bool)
 
-	for field := range inStruct.Fields() {
-		if field.Exported() {
-			inFieldNames[field.Name()] = true
+	for _, inStruct := range inStructs {
+		for field := range inStruct.Fields() {
+			if field.Exported() {
+				inFieldNames[field.Name()] = true
+			}
 		}
 	}
 
-	for field := range outStruct.Fields() {
-		if field.Exported() {
-			outFieldNames[field.Name()] = true
+	for _, outStruct := range outStructs {
+		for field := range outStruct.Fields() {
+			if field.Exported() {
+				outFieldNames[field.Name()] = true
+			}
 		}
 	}
 
@@ -881,45 +1196,52 @@ func filterMissingFieldsByValidationMode(
 // ValidateConverter checks that the converter function fn uses every field
 // of the candidate input model (by reading) and every field of the candidate output model (by writing).
 //
-// For input, we assume the candidate comes from the first parameter and that it has a name.
+// For input, the candidate is the first struct parameter (or the receiver, see
+// converterInputs), which must have a name; further plain or pointer struct parameters
+// are checked as additional inputs.
 // For output, we first try to use a named result; if none, we look for a composite literal.
 func ValidateConverter(fn *ast.FuncDecl, pass *analysis.Pass, cfg *config.Config) (*ConverterValidationResult, error) {
-	// Retrieve the function object and signature.
-	obj := pass.TypesInfo.Defs[fn.Name]
-	if obj == nil {
-		return nil, fmt.Errorf("cannot get type info for function %q", fn.Name.Name)
-	}
-	sig, ok := obj.Type().(*types.Signature)
+	return validateConverter(fn, pass, cfg, newHelperSummaries(pass, cfg))
+}
+
+// validateConverter is ValidateConverter crediting fn with the fields mapped by the
+// package helpers it hands its input or output to (see helperSummaries).
+func validateConverter(
+	fn *ast.FuncDecl,
+	pass *analysis.Pass,
+	cfg *config.Config,
+	helpers *helperSummaries,
+) (*ConverterValidationResult, error) {
+	// Retrieve the function signature.
+	sig, ok := funcSignature(fn, pass)
 	if !ok {
-		return nil, fmt.Errorf("function %q does not have a valid signature", fn.Name.Name)
-	}
-	if sig.Params().Len() < 1 || sig.Results().Len() < 1 {
-		return nil, fmt.Errorf(
-			"function %q must have at least one parameter and one result",
-			fn.Name.Name,
-		)
+		return nil, fmt.Errorf("cannot get type info for function %q", fn.Name.Name)
 	}
+	inputs, outputs := converterSides(pass.TypesInfo, fn, sig, cfg)
 
 	// Find the candidate input parameter.
-	inCand, inVar, okIn := findCandidateParam(fn.Type.Params, sig.Params())
-	if !okIn || inVar == "" {
+	if len(inputs) == 0 || inputs[0].name == "" {
 		return nil, fmt.Errorf(
 			"cannot determine candidate input parameter for function %q",
 			fn.Name.Name,
 		)
 	}
+	inCand, inVar := inputs[0].cand, inputs[0].name
 
-	// Determine the candidate output parameter.
-	outCand, outVar, okOut := findCandidateParam(fn.Type.Results, sig.Results())
-	if !okOut {
+	// Determine the candidate output parameters. The first one drives the converter shape
+	// (delegating, aggregating, slice inline) and the suggested fixes; a multi-output
+	// converter has every one of them validated.
+	if len(outputs) == 0 {
 		return nil, fmt.Errorf(
 			"cannot determine candidate output parameter for function %q",
 			fn.Name.Name,
 		)
 	}
+	outCand, outVar := outputs[0].cand, outputs[0].name
+	multiOutput := len(outputs) > 1
 
 	// Check if this is a delegating converter (e.g., converts a slice by calling another converter on each element)
-	if isDelegatingConverter(fn, inCand, outCand, inVar) {
+	if isDelegatingConverter(pass.TypesInfo, fn, inCand, outCand, inVar) {
 		// For delegating converters, skip validation since the actual field mapping
 		// is delegated to the inner converter function which will be linted separately
 		result := NewOKConverterValidationResult()
@@ -931,12 +1253,30 @@ func ValidateConverter(fn *ast.FuncDecl, pass *analysis.Pass, cfg *config.Config
 	// the callee is analyzed on its own, so validating this one reports every field on
 	// both sides. Requiring that it builds no part of the output keeps the mixed shape
 	// (one branch forwards, another fills a literal) under validation, where it belongs.
-	if forwardsWholeInput(fn, inVar) && len(CollectOutputFields(fn, outVar, outCand.name)) == 0 {
+	if (forwardsWholeInput(pass.TypesInfo, fn, inVar) || fillsThroughCall(pass.TypesInfo, fn, inVar, outputs)) &&
+		buildsNoOutput(pass.TypesInfo, fn, outputs, cfg.SetterPatterns) {
 		result := NewOKConverterValidationResult()
 		result.ConverterType = ConverterTypeDelegating
 		return result, nil
 	}
 
+	// A conversion between structs of identical fields (UserDTO(u)) maps every one of them.
+	if convertsWholeInput(fn, pass, inVar, outCand) {
+		result := NewOKConverterValidationResult()
+		result.ConverterType = ConverterTypeConversion
+		return result, nil
+	}
+
+	// A reflective copy (copier.Copy(&out, &in)) maps fields the code never names. Trusted,
+	// it maps them all; otherwise the fields it pairs by name count as used, and the
+	// rest is reported as an unchecked reflective copy.
+	copyCall, copyName := reflectiveCopy(fn, pass, cfg, inVar, outCand)
+	if copyCall != nil && cfg.CopyHandling == config.CopyHandlingTrust {
+		result := NewOKConverterValidationResult()
+		result.ConverterType = ConverterTypeCopy
+		return result, nil
+	}
+
 	// Check if this is an aggregating converter (slice -> non-slice with slice field)
 	if isAgg, sliceFieldName := isAggregatingConverter(inCand, outCand); isAgg {
 		result := validateAggregatingConverter(fn, inCand, outCand, inVar, sliceFieldName, pass, cfg)
@@ -955,54 +1295,237 @@ func ValidateConverter(fn *ast.FuncDecl, pass *analysis.Pass, cfg *config.Config
 	inFieldVar := inVar
 	if inCand.containerType == outCand.containerType &&
 		(inCand.containerType == ContainerSlice || inCand.containerType == ContainerMap) {
-		if loopVar := findLoopVariable(fn, inVar); loopVar != "" {
+		if loopVar := findLoopVariable(pass.TypesInfo, fn, inVar); loopVar != "" {
 			inFieldVar = loopVar
 		}
 	}
-	fieldsUsedModelIn := CollectUsedFields(fn.Body, inFieldVar)
-	methodsUsedModelIn := CollectUsedMethods(fn.Body, inFieldVar)
+	inUsage := helpers.collect(fn.Body, inFieldVar)
+	fieldsUsedModelIn, methodsUsedModelIn := inUsage.fields, inUsage.methods
+	if inFieldVar != inVar {
+		usage := helpers.collect(fn.Body, inVar)
+		maps.Copy(fieldsUsedModelIn, usage.fields)
+		maps.Copy(methodsUsedModelIn, usage.methods)
+	}
+	if copyCall != nil {
+		maps.Copy(fieldsUsedModelIn, copiedFields(inCand.structType, outCand.structType))
+	}
+	extras := extraInputs(inputs, outputs, cfg)
+	inVars := []string{inVar, inFieldVar}
+	for _, extra := range extras {
+		inVars = append(inVars, extra.name)
+	}
+	acks := collectAcknowledgements(fn, pass, inVars, outVar, outCand.name)
+	openedIn := collectOpenedFields(cfg, pass.TypesInfo, fn.Body, inFieldVar, nil)
 	if inFieldVar != inVar {
-		maps.Copy(fieldsUsedModelIn, CollectUsedFields(fn.Body, inVar))
-		maps.Copy(methodsUsedModelIn, CollectUsedMethods(fn.Body, inVar))
+		maps.Copy(openedIn, collectOpenedFields(cfg, pass.TypesInfo, fn.Body, inVar, nil))
+	}
+	inFields := fieldUsage{
+		fields:       fieldsUsedModelIn,
+		methods:      methodsUsedModelIn,
+		acknowledged: acks.input(inVar, inFieldVar),
+		opened:       openedIn,
+	}
+	missingIn := collectMissingFields(inCand.structType, inFields, pass, cfg)
+	// Go does not allow selecting fields through a type parameter, so a generic converter
+	// can only hand its input on whole: into a DTO[T] field, or to another call. That
+	// delegates the mapping through the type argument; only an input never used is lost.
+	if inCand.typeParam && resolveVar(pass.TypesInfo, fn.Body, inVar).usedIn(fn.Body) {
+		missingIn = nil
 	}
-	missingIn := collectMissingFields(inCand.structType, fieldsUsedModelIn, pass, cfg, methodsUsedModelIn)
 	for i, m := range missingIn {
 		missingIn[i] = inFieldVar + "." + m
 	}
 
-	// Collect field usages for the output candidate.
-	fieldsUsedModelOut := CollectOutputFields(fn, outVar, outCand.name)
-	missingOut := collectMissingFields(outCand.structType, fieldsUsedModelOut, pass, cfg)
-	if outVar != "" {
-		for i, m := range missingOut {
-			missingOut[i] = outVar + "." + m
+	// Dataflow mode also requires the values of the fields used to flow from the input
+	// to the output (see valueFlow).
+	flow := helpers.valueFlow(fn, sig, inputs, outputs)
+	var lostIn []string
+	if flow != nil && inFieldVar == inVar {
+		for _, m := range unflowedFields(inCand.structType, inFields, flow.lostFields(inVar), pass, cfg) {
+			lostIn = append(lostIn, inVar+"."+m)
 		}
 	}
 
-	// Apply non-marshallable fields filtering based on configuration
-	missingIn, missingOut = filterMissingFieldsByNonMarshallableMode(
-		missingIn,
-		missingOut,
-		inCand.structType,
-		outCand.structType,
-		cfg,
-	)
+	// A multi-input converter merges several structs into its output. Each further input
+	// is checked like the first, and its unread fields are reported under its own name.
+	inStructs := []*types.Struct{inCand.structType}
+	inStructsByVar := map[string]*types.Struct{inFieldVar: inCand.structType}
+	var extraInVars []string
+	for _, extra := range extras {
+		extraUsage := helpers.collect(fn.Body, extra.name)
+		extraFields := fieldUsage{
+			fields:       extraUsage.fields,
+			methods:      extraUsage.methods,
+			acknowledged: acks.input(extra.name),
+			opened:       collectOpenedFields(cfg, pass.TypesInfo, fn.Body, extra.name, nil),
+		}
+		missing := collectMissingFields(extra.cand.structType, extraFields, pass, cfg)
+		if extra.cand.typeParam && resolveVar(pass.TypesInfo, fn.Body, extra.name).usedIn(fn.Body) {
+			missing = nil
+		}
+		for _, m := range missing {
+			missingIn = append(missingIn, extra.name+"."+m)
+		}
+		if flow != nil {
+			for _, m := range unflowedFields(extra.cand.structType, extraFields, flow.lostFields(extra.name), pass, cfg) {
+				lostIn = append(lostIn, extra.name+"."+m)
+			}
+		}
+		inStructs = append(inStructs, extra.cand.structType)
+		inStructsByVar[extra.name] = extra.cand.structType
+		extraInVars = append(extraInVars, extra.name)
+	}
+
+	outStructs := make([]*types.Struct, len(outputs))
+	for i, out := range outputs {
+		outStructs[i] = out.cand.structType
+	}
+
+	// Apply non-marshallable fields filtering and field validation mode filtering
+	// based on configuration
+	missingIn, _ = filterMissingFieldsByNonMarshallableMode(missingIn, nil, inStructs, outStructs, cfg)
+	missingIn, _ = filterMissingFieldsByValidationMode(missingIn, nil, inStructs, outStructs, cfg)
+	lostIn, _ = filterMissingFieldsByNonMarshallableMode(lostIn, nil, inStructs, outStructs, cfg)
+	lostIn, _ = filterMissingFieldsByValidationMode(lostIn, nil, inStructs, outStructs, cfg)
+
+	// Collect field usages for each output candidate. With several results, a return
+	// statement's literals count for the result at their position only, and unnamed
+	// results are reported under their type name.
+	var missingOut []string
+	var perOutput []outputFields
+	var returnPaths []ReturnFields
+	var nestedCalls []NestedCall
+	var constantOut []string
+	var suspicious []SuspiciousMapping
+	var overwritten []OverwrittenField
+	positions := resultPositions(sig)
+	for i, out := range outputs {
+		resultIndex := -1
+		if multiOutput {
+			resultIndex = i
+		}
+		outAcks := acks.out
+		if i > 0 {
+			outAcks = collectAcknowledgements(fn, pass, inVars, out.name, out.cand.name).out
+		}
+		outFields := CollectResultFields(pass.TypesInfo, fn, out.name, out.cand.name, resultIndex, cfg.SetterPatterns)
+		outVariable := outputVariable(fn, out.name, out.cand.name)
+		if outVariable != "" {
+			maps.Copy(outFields, helpers.collect(fn.Body, outVariable).written())
+			// Within nested-depth, a literal assigned to a nested field sets its keys
+			// (out.Owner = &UserDTO{ID: ...} sets Owner.ID); the default keeps it out.
+			if cfg.NestedDepth != 0 {
+				extractAssignedLiteralKeys(pass.TypesInfo, fn.Body, outVariable, outFields)
+			}
+		}
+		if copyCall != nil && i == 0 {
+			maps.Copy(outFields, copiedFields(out.cand.structType, inCand.structType))
+		}
+		outLits := outputCompositeLitsAt(fn, out.cand.name, resultIndex)
+		openedOut := collectOpenedFields(cfg, pass.TypesInfo, fn.Body, outVariable, outLits)
+		outUsage := fieldUsage{
+			fields:       outFields,
+			acknowledged: outAcks,
+			opened:       openedOut,
+		}
+		missing := collectMissingFields(out.cand.structType, outUsage, pass, cfg)
+		var constant []string
+		if flow != nil {
+			constant = unflowedFields(out.cand.structType, outUsage, flow.constantFields(i), pass, cfg)
+		}
 
-	// Apply field validation mode filtering based on configuration
-	missingIn, missingOut = filterMissingFieldsByValidationMode(
-		missingIn,
-		missingOut,
-		inCand.structType,
-		outCand.structType,
-		cfg,
-	)
+		prefix := out.name
+		if prefix == "" && multiOutput {
+			prefix = out.cand.name
+		}
+		if prefix != "" {
+			for j, m := range missing {
+				missing[j] = prefix + "." + m
+			}
+			for j, m := range constant {
+				constant[j] = prefix + "." + m
+			}
+		}
+
+		_, missing = filterMissingFieldsByNonMarshallableMode(nil, missing, inStructs, outStructs, cfg)
+		_, missing = filterMissingFieldsByValidationMode(nil, missing, inStructs, outStructs, cfg)
+		_, constant = filterMissingFieldsByNonMarshallableMode(nil, constant, inStructs, outStructs, cfg)
+		_, constant = filterMissingFieldsByValidationMode(nil, constant, inStructs, outStructs, cfg)
+		constantOut = append(constantOut, constant...)
+
+		for _, nc := range helpers.nestedConverterCalls(fn, inVars, outVariable, outLits) {
+			nc.Field = joinPath(prefix, nc.Field)
+			nestedCalls = append(nestedCalls, nc)
+		}
+		if cfg.SuspiciousMappings {
+			suspicious = append(suspicious,
+				suspiciousMappings(pass.TypesInfo, fn, out.cand.structType, outVariable, outLits, inStructsByVar, missingIn, prefix)...)
+		}
+		if cfg.OverwrittenFields &&
+			(out.cand.containerType == ContainerNone || out.cand.containerType == ContainerPointer) {
+			overwritten = append(overwritten,
+				overwrittenFields(pass.TypesInfo, fn, outVariable, out.cand.structType, out.cand.name, prefix)...)
+		}
+
+		missingOut = append(missingOut, missing...)
+		perOutput = append(perOutput, outputFields{prefix: prefix, missing: missing})
+
+		// Path-sensitive mode checks the struct results (not fill targets) return by return.
+		if cfg.PathSensitive && len(positions) == len(outputs) &&
+			(out.cand.containerType == ContainerNone || out.cand.containerType == ContainerPointer) {
+			for _, path := range outputReturnPaths(fn, sig, out.name, out.cand.name, positions[i], helpers) {
+				pathMissing := collectMissingFields(out.cand.structType, fieldUsage{
+					fields:       path.fields,
+					acknowledged: outAcks,
+					opened:       openedOut,
+				}, pass, cfg)
+				if prefix != "" {
+					for j, m := range pathMissing {
+						pathMissing[j] = prefix + "." + m
+					}
+				}
+				_, pathMissing = filterMissingFieldsByNonMarshallableMode(nil, pathMissing, inStructs, outStructs, cfg)
+				_, pathMissing = filterMissingFieldsByValidationMode(nil, pathMissing, inStructs, outStructs, cfg)
+				// Fields missing on every path are already reported for the converter.
+				pathMissing = slices.DeleteFunc(pathMissing, func(m string) bool { return slices.Contains(missing, m) })
+				if len(pathMissing) > 0 {
+					returnPaths = append(returnPaths, ReturnFields{Return: path.ret, Missing: pathMissing})
+				}
+			}
+		}
+	}
 
 	if len(missingIn) == 0 && len(missingOut) == 0 {
-		return NewOKConverterValidationResult(), nil
+		result := NewOKConverterValidationResult()
+		if len(returnPaths) > 0 || len(nestedCalls) > 0 || len(lostIn)+len(constantOut) > 0 ||
+			len(suspicious)+len(overwritten) > 0 {
+			result.Valid = len(lostIn)+len(constantOut) == 0
+			result.ConverterType = ConverterTypeNormal
+			result.ReturnPaths = returnPaths
+			result.NestedCalls = nestedCalls
+			result.LostInputFields = lostIn
+			result.ConstantOutputFields = constantOut
+			result.SuspiciousMappings = suspicious
+			result.OverwrittenFields = overwritten
+		}
+		return result, nil
 	}
 
 	result := NewFailedConverterValidationResult(missingIn, missingOut)
 	result.ConverterType = ConverterTypeNormal
+	if copyCall != nil {
+		result.ConverterType = ConverterTypeCopy
+		result.CopyCall = copyName
+	}
+	result.ReturnPaths = returnPaths
+	result.NestedCalls = nestedCalls
+	result.LostInputFields = lostIn
+	result.ConstantOutputFields = constantOut
+	result.SuspiciousMappings = suspicious
+	result.OverwrittenFields = overwritten
+	if multiOutput {
+		result.outputs = perOutput
+	}
 
 	// Build FixContext only when fix mode is enabled to avoid unnecessary AST walks.
 	if cfg.FixMode != config.FixModeDisabled {
@@ -1035,19 +1558,28 @@ func ValidateConverter(fn *ast.FuncDecl, pass *analysis.Pass, cfg *config.Config
 			OutputStyle:   outputStyle,
 			CompLitRbrace: compLitRbrace,
 			IsSliceInline: isSliceInline,
+			ExtraInVars:   extraInVars,
+			OutSetters:    outputSetters(outCand.fullType, cfg.SetterPatterns),
 		}
 	}
 
 	return result, nil
 }
 
-// findCandidateParam searches the appropriate FieldList (for input or output)
-// for the first parameter/result that qualifies as a candidate type.
-// It returns the candidate info, the variable name (if any) and true on success.
-func findCandidateParam(fieldList *ast.FieldList, sigParams *types.Tuple) (candidate, string, bool) {
+// candidateParam is a parameter or result whose type qualifies as a candidate,
+// with its declared name ("" for unnamed results).
+type candidateParam struct {
+	cand candidate
+	name string
+}
+
+// findCandidateParams returns every parameter/result of the FieldList (for input or
+// output) that qualifies as a candidate type, in declaration order.
+func findCandidateParams(fieldList *ast.FieldList, sigParams *types.Tuple) []candidateParam {
 	if fieldList == nil {
-		return candidate{}, "", false
+		return nil
 	}
+	var res []candidateParam
 	// Keep a running count to match the order of parameters/results in sigParams.
 	paramIndex := 0
 	for _, field := range fieldList.List {
@@ -1062,22 +1594,143 @@ func findCandidateParam(fieldList *ast.FieldList, sigParams *types.Tuple) (candi
 		for i := range n {
 			// Get the type from the signature.
 			if paramIndex >= sigParams.Len() {
-				break
+				return res
 			}
 			paramVar := sigParams.At(paramIndex)
+			paramIndex++
 			if c, ok := extractCandidateType(paramVar.Type()); ok {
-				// If the AST field has names, use the first one (or the one corresponding to our index).
+				// If the AST field has names, use the one corresponding to our index.
+				name := ""
 				if len(names) > 0 {
-					return c, names[i].Name, true
+					name = names[i].Name
 				}
-				// Otherwise, return with an empty variable name.
-				return c, "", true
+				res = append(res, candidateParam{cand: c, name: name})
 			}
-			paramIndex++
 		}
-		paramIndex += (n - 1)
 	}
-	return candidate{}, "", false
+	return res
+}
+
+// receiverParam returns the receiver of a method as a candidate input, if its type
+// qualifies and it has a usable name.
+func receiverParam(fn *ast.FuncDecl, sig *types.Signature) (candidateParam, bool) {
+	if sig.Recv() == nil || fn.Recv == nil || len(fn.Recv.List) == 0 || len(fn.Recv.List[0].Names) == 0 {
+		return candidateParam{}, false
+	}
+	name := fn.Recv.List[0].Names[0].Name
+	if name == "_" {
+		return candidateParam{}, false
+	}
+	cand, ok := extractCandidateType(sig.Recv().Type())
+	if !ok {
+		return candidateParam{}, false
+	}
+	return candidateParam{cand: cand, name: name}, true
+}
+
+// converterInputs returns the candidate inputs of fn, the primary one first. These are
+// the struct parameters, plus the receiver as configured by receiver-input: in place of
+// parameters when there are none ("func (u User) ToDTO() UserDTO"), or ahead of them.
+func converterInputs(fn *ast.FuncDecl, sig *types.Signature, cfg *config.Config) []candidateParam {
+	params := findCandidateParams(fn.Type.Params, sig.Params())
+	recv, ok := receiverParam(fn, sig)
+	switch {
+	case !ok:
+		return params
+	case cfg.ReceiverInput == config.ReceiverPrefer:
+		return append([]candidateParam{recv}, params...)
+	case len(params) == 0:
+		return []candidateParam{recv}
+	}
+	return params
+}
+
+// converterSides returns the candidate inputs and outputs of fn. The outputs are its
+// struct results; a fill-style function without any writes its output through pointer
+// targets instead (see outputTargets), and those are then not inputs.
+func converterSides(
+	info *types.Info,
+	fn *ast.FuncDecl,
+	sig *types.Signature,
+	cfg *config.Config,
+) ([]candidateParam, []candidateParam) {
+	inputs := converterInputs(fn, sig, cfg)
+	if outputs := findCandidateParams(fn.Type.Results, sig.Results()); len(outputs) > 0 {
+		return inputs, outputs
+	}
+
+	targets := outputTargets(info, fn, sig, cfg.SetterPatterns)
+	inputs = slices.DeleteFunc(inputs, func(in candidateParam) bool {
+		return slices.ContainsFunc(targets, func(t candidateParam) bool { return t.name == in.name })
+	})
+	return inputs, targets
+}
+
+// outputTargets returns the pointer receiver and pointer parameters that fn writes
+// through: the outputs of "func fillDTO(dst *UserDTO, src User)",
+// "func (d *UserDTO) FromDomain(u User)" and "func apply(dst *User, patch UserPatch)".
+// A pointer that is only read stays an input; one filled through setters
+// (dst.SetName(u.Name)) is written.
+func outputTargets(info *types.Info, fn *ast.FuncDecl, sig *types.Signature, setterPatterns []string) []candidateParam {
+	var candidates []candidateParam
+	if recv, ok := receiverParam(fn, sig); ok {
+		candidates = append(candidates, recv)
+	}
+	candidates = append(candidates, findCandidateParams(fn.Type.Params, sig.Params())...)
+
+	var targets []candidateParam
+	for _, c := range candidates {
+		if c.name == "" || c.name == "_" || c.cand.containerType != ContainerPointer {
+			continue
+		}
+		target := resolveVar(info, fn.Body, c.name)
+		if writesThrough(fn.Body, target) || len(collectSetters(fn.Body, target, setterPatterns)) > 0 {
+			targets = append(targets, c)
+		}
+	}
+	return targets
+}
+
+// extraInputs returns the candidate inputs after the first that a multi-input
+// converter merges into its output: "func ToProfileDTO(u User, s Settings) ProfileDTO".
+// Only plain and pointer structs qualify, and only those sharing a field name with one
+// of outputs or pairing with it by name like the primary input: a dependency or an
+// options struct (db *gorm.DB, opts Options) steers the conversion without being
+// converted. A blank parameter is deliberately unused.
+func extraInputs(inputs, outputs []candidateParam, cfg *config.Config) []candidateParam {
+	if len(inputs) < 2 {
+		return nil
+	}
+	var res []candidateParam
+	for _, p := range inputs[1:] {
+		if p.name == "" || p.name == "_" {
+			continue
+		}
+		if p.cand.containerType != ContainerNone && p.cand.containerType != ContainerPointer {
+			continue
+		}
+		merged := slices.ContainsFunc(outputs, func(out candidateParam) bool {
+			return sharesFieldName(p.cand.structType, out.cand.structType) ||
+				isConverterPair(p.cand, out.cand, cfg, false)
+		})
+		if merged {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+// sharesFieldName reports whether a and b have a field of the same name.
+func sharesFieldName(a, b *types.Struct) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	for i := range a.NumFields() {
+		if _, ok := structField(b, a.Field(i).Name()); ok {
+			return true
+		}
+	}
+	return false
 }
 
 // isDelegatingConverter checks if a function is a delegating converter:
@@ -1088,6 +1741,7 @@ func findCandidateParam(fieldList *ast.FieldList, sigParams *types.Tuple) (candi
 //
 // Returns true if this pattern is detected (and validation should be skipped).
 func isDelegatingConverter(
+	info *types.Info,
 	fn *ast.FuncDecl,
 	inCand candidate,
 	outCand candidate,
@@ -1099,6 +1753,7 @@ func isDelegatingConverter(
 	}
 
 	// Look for a range loop over the input variable
+	input := resolveVar(info, fn.Body, inVar)
 	var foundLoop bool
 	var loopVar string
 	ast.Inspect(fn.Body, func(n ast.Node) bool {
@@ -1109,7 +1764,7 @@ func isDelegatingConverter(
 
 		// Check if looping over the input variable
 		ident, ok := rangeStmt.X.(*ast.Ident)
-		if !ok || ident.Name != inVar {
+		if !ok || !input.is(ident) {
 			return true
 		}
 
@@ -1131,7 +1786,7 @@ func isDelegatingConverter(
 	// Require the call to actually take in[i]: the looser evidence below would accept any
 	// append or indexed call assignment, which for this shape says nothing about delegation.
 	if loopVar == "" {
-		return delegatesByIndex(fn, inVar)
+		return delegatesByIndex(fn, input)
 	}
 
 	// Look for function calls with the loop variable as argument
@@ -1172,7 +1827,8 @@ func isDelegatingConverter(
 // forwardsWholeInput reports whether some return in fn hands the input variable itself to
 // another function, as in "return newCuratorResponse(in)". Callers must also check that fn
 // builds no output of its own before treating that as delegation.
-func forwardsWholeInput(fn *ast.FuncDecl, inVar string) bool {
+func forwardsWholeInput(info *types.Info, fn *ast.FuncDecl, inVar string) bool {
+	input := resolveVar(info, fn.Body, inVar)
 	var found bool
 	ast.Inspect(fn.Body, func(n ast.Node) bool {
 		ret, ok := n.(*ast.ReturnStmt)
@@ -1184,11 +1840,9 @@ func forwardsWholeInput(fn *ast.FuncDecl, inVar string) bool {
 			if !okCall {
 				continue
 			}
-			for _, arg := range call.Args {
-				if isVarRef(arg, inVar) {
-					found = true
-					return false
-				}
+			if callPassesVar(call, input) {
+				found = true
+				return false
 			}
 		}
 		return true
@@ -1196,26 +1850,69 @@ func forwardsWholeInput(fn *ast.FuncDecl, inVar string) bool {
 	return found
 }
 
-// isVarRef reports whether expr refers to varName directly, seeing through &v, *v and (v).
-func isVarRef(expr ast.Expr, varName string) bool {
-	switch x := expr.(type) {
-	case *ast.Ident:
-		return x.Name == varName
-	case *ast.UnaryExpr:
-		if x.Op == token.AND {
-			return isVarRef(x.X, varName)
+// callPassesVar reports whether call receives target as an argument, directly or
+// through nested calls and conversions: convert(in), convert(User(in)), wrap(convert(in)).
+// A method called on target itself receives it too: in.ToDTO() delegates to the
+// receiver converter.
+func callPassesVar(call *ast.CallExpr, target varTarget) bool {
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok && target.ref(sel.X) {
+		return true
+	}
+	for _, arg := range call.Args {
+		if target.ref(arg) {
+			return true
+		}
+		if inner, ok := ast.Unparen(arg).(*ast.CallExpr); ok && callPassesVar(inner, target) {
+			return true
 		}
-	case *ast.StarExpr:
-		return isVarRef(x.X, varName)
-	case *ast.ParenExpr:
-		return isVarRef(x.X, varName)
 	}
 	return false
 }
 
+// fillsThroughCall reports whether fn hands its input and one of its named outputs to
+// another function in a call statement: "fillDTO(dst, u)", "dst.FromDomain(u)" or
+// "fillDTO(&out, in)". That is how fill-style converters delegate.
+func fillsThroughCall(info *types.Info, fn *ast.FuncDecl, inVar string, outputs []candidateParam) bool {
+	input := resolveVar(info, fn.Body, inVar)
+	var targets []varTarget
+	for _, out := range outputs {
+		if out.name != "" {
+			targets = append(targets, resolveVar(info, fn.Body, out.name))
+		}
+	}
+	var found bool
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		stmt, ok := n.(*ast.ExprStmt)
+		if !ok {
+			return !found
+		}
+		call, ok := stmt.X.(*ast.CallExpr)
+		if !ok || !callPassesVar(call, input) {
+			return true
+		}
+		for _, output := range targets {
+			if callPassesVar(call, output) {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// buildsNoOutput reports whether fn sets no field of any of its outputs.
+func buildsNoOutput(info *types.Info, fn *ast.FuncDecl, outputs []candidateParam, setterPatterns []string) bool {
+	for _, out := range outputs {
+		if len(CollectOutputFields(info, fn, out.name, out.cand.name, setterPatterns)) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
 // delegatesByIndex reports whether fn stores the result of a call that receives a whole
-// element of inVar reached by indexing, as in "out[i] = convertOne(in[i])".
-func delegatesByIndex(fn *ast.FuncDecl, inVar string) bool {
+// element of input reached by indexing, as in "out[i] = convertOne(in[i])".
+func delegatesByIndex(fn *ast.FuncDecl, input varTarget) bool {
 	var found bool
 	ast.Inspect(fn.Body, func(n ast.Node) bool {
 		assign, ok := n.(*ast.AssignStmt)
@@ -1224,7 +1921,7 @@ func delegatesByIndex(fn *ast.FuncDecl, inVar string) bool {
 		}
 		for _, rhs := range assign.Rhs {
 			call, okCall := rhs.(*ast.CallExpr)
-			if okCall && callTakesElementOf(call, inVar) {
+			if okCall && callTakesElementOf(call, input) {
 				found = true
 				return false
 			}
@@ -1235,31 +1932,31 @@ func delegatesByIndex(fn *ast.FuncDecl, inVar string) bool {
 }
 
 // callTakesElementOf reports whether call, or a call nested in its arguments (as in
-// append(out, convertOne(in[i]))), is passed an indexed element of varName.
-func callTakesElementOf(call *ast.CallExpr, varName string) bool {
+// append(out, convertOne(in[i]))), is passed an indexed element of target.
+func callTakesElementOf(call *ast.CallExpr, target varTarget) bool {
 	for _, arg := range call.Args {
-		if indexesVar(arg, varName) {
+		if indexesVar(arg, target) {
 			return true
 		}
-		if inner, ok := arg.(*ast.CallExpr); ok && callTakesElementOf(inner, varName) {
+		if inner, ok := arg.(*ast.CallExpr); ok && callTakesElementOf(inner, target) {
 			return true
 		}
 	}
 	return false
 }
 
-// indexesVar reports whether expr indexes varName (in[i]), possibly behind & or parentheses.
-func indexesVar(expr ast.Expr, varName string) bool {
+// indexesVar reports whether expr indexes target (in[i]), possibly behind & or parentheses.
+func indexesVar(expr ast.Expr, target varTarget) bool {
 	switch x := expr.(type) {
 	case *ast.IndexExpr:
 		ident, ok := x.X.(*ast.Ident)
-		return ok && ident.Name == varName
+		return ok && target.is(ident)
 	case *ast.UnaryExpr:
 		if x.Op == token.AND {
-			return indexesVar(x.X, varName)
+			return indexesVar(x.X, target)
 		}
 	case *ast.ParenExpr:
-		return indexesVar(x.X, varName)
+		return indexesVar(x.X, target)
 	}
 	return false
 }
@@ -1298,9 +1995,12 @@ func isAggregatingConverter(inCand, outCand candidate) (bool, string) {
 }
 
 // findLoopVariable finds the loop variable used in a range loop over the input slice.
-// For example, in "for _, detail := range details", it returns "detail".
-func findLoopVariable(fn *ast.FuncDecl, inVar string) string {
+// For example, in "for _, detail := range details", it returns "detail". The ranged
+// variable is resolved through info, so a range over a closure parameter or a variable
+// shadowing the input is not taken for one over the input.
+func findLoopVariable(info *types.Info, fn *ast.FuncDecl, inVar string) string {
 	var loopVar string
+	input := resolveVar(info, fn.Body, inVar)
 	ast.Inspect(fn.Body, func(n ast.Node) bool {
 		if loopVar != "" {
 			return false
@@ -1312,7 +2012,7 @@ func findLoopVariable(fn *ast.FuncDecl, inVar string) string {
 
 		// Check if looping over the input variable
 		ident, ok := rangeStmt.X.(*ast.Ident)
-		if !ok || ident.Name != inVar {
+		if !ok || !input.is(ident) {
 			return true
 		}
 
@@ -1342,20 +2042,12 @@ func validateAggregatingConverter(
 	cfg *config.Config,
 ) *ConverterValidationResult {
 	// Find the loop variable (e.g., "detail" in "for _, detail := range details")
-	loopVar := findLoopVariable(fn, inVar)
+	loopVar := findLoopVariable(pass.TypesInfo, fn, inVar)
 	if loopVar == "" {
 		// If we can't find a loop variable, it's not a proper aggregating converter
 		return NewOKConverterValidationResult()
 	}
 
-	// Validate that all input fields are used through the loop variable
-	fieldsUsedModelIn := CollectUsedFields(fn.Body, loopVar)
-	methodsUsedModelIn := CollectUsedMethods(fn.Body, loopVar)
-	missingIn := collectMissingFields(inCand.structType, fieldsUsedModelIn, pass, cfg, methodsUsedModelIn)
-	for i, m := range missingIn {
-		missingIn[i] = loopVar + "." + m
-	}
-
 	// Get the slice field and extract its element type
 	var sliceElemType *types.Struct
 	var sliceElemTypeName string
@@ -1379,9 +2071,25 @@ func validateAggregatingConverter(
 		return NewOKConverterValidationResult()
 	}
 
+	// Validate that all input fields are used through the loop variable
+	fieldsUsedModelIn := CollectUsedFields(pass.TypesInfo, fn.Body, loopVar)
+	methodsUsedModelIn := CollectUsedMethods(pass.TypesInfo, fn.Body, loopVar)
+	acks := collectAcknowledgements(fn, pass, []string{inVar, loopVar}, "", sliceElemTypeName)
+	missingIn := collectMissingFields(inCand.structType, fieldUsage{
+		fields:       fieldsUsedModelIn,
+		methods:      methodsUsedModelIn,
+		acknowledged: acks.input(inVar, loopVar),
+	}, pass, cfg)
+	for i, m := range missingIn {
+		missingIn[i] = loopVar + "." + m
+	}
+
 	// Collect fields that are set in composite literals of the slice element type
-	fieldsUsedInSliceElem := CollectOutputFields(fn, "", sliceElemTypeName)
-	missingOut := collectMissingFields(sliceElemType, fieldsUsedInSliceElem, pass, cfg)
+	fieldsUsedInSliceElem := CollectOutputFields(pass.TypesInfo, fn, "", sliceElemTypeName, cfg.SetterPatterns)
+	missingOut := collectMissingFields(sliceElemType, fieldUsage{
+		fields:       fieldsUsedInSliceElem,
+		acknowledged: acks.out,
+	}, pass, cfg)
 	if len(missingOut) > 0 {
 		for i, m := range missingOut {
 			missingOut[i] = sliceFieldName + "[]." + m
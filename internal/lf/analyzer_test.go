@@ -6,6 +6,7 @@ import (
 	"go/token"
 	"go/types"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"golang.org/x/tools/go/analysis"
@@ -351,6 +352,658 @@ func TestIndexedAccess(t *testing.T) {
 	})
 }
 
+func TestDirectives(t *testing.T) {
+	// //lostfield:converter forces analysis of a pair the name heuristics reject,
+	// //lostfield:ignore and //lostfield:file-ignore suppress findings, and directives
+	// that suppress nothing (or are misspelled) are reported after the converter findings.
+	runAnalysisTest(t, "converters/22-directives",
+		DiagnosticAssertion{
+			FunctionName:  "AccountToProfile",
+			FieldsMissing: []string{"a.Plan", "Plan"},
+		},
+		DiagnosticAssertion{FunctionName: "ConvertUser: unused //lostfield:ignore directive"},
+		DiagnosticAssertion{FunctionName: "Sum: //lostfield:converter directive has no effect"},
+		DiagnosticAssertion{FunctionName: "unknown directive //lostfield:ignroe"},
+		DiagnosticAssertion{FunctionName: "unused //lostfield:file-ignore directive"},
+	)
+}
+
+func TestFieldAcknowledgements(t *testing.T) {
+	t.Run("23-field-acknowledgements:clean", func(t *testing.T) {
+		// //lostfield:skip and "// Field: intentionally omitted" count as handled,
+		// including nested paths.
+		runAnalysisTest(t, "converters/23-field-acknowledgements/clean")
+	})
+
+	t.Run("23-field-acknowledgements:dirty", func(t *testing.T) {
+		// Acknowledgements apply only to the side and level they name.
+		runAnalysisTest(t, "converters/23-field-acknowledgements/dirty",
+			DiagnosticAssertion{
+				FunctionName:  "ConvertUserSkipInputOnly",
+				FieldsMissing: []string{"Email"},
+			},
+			DiagnosticAssertion{
+				FunctionName:  "ConvertUserOmittedWrongLevel",
+				FieldsMissing: []string{"in.Role.Name", "Role.Name"},
+			},
+			DiagnosticAssertion{
+				FunctionName:  "ConvertUserPlainComment",
+				FieldsMissing: []string{"in.Email", "Email"},
+			},
+		)
+	})
+}
+
+func TestGenerics(t *testing.T) {
+	t.Run("24-generics:clean", func(t *testing.T) {
+		// Type parameters are checked through their core struct type, instantiated
+		// generic types against their instantiation.
+		runAnalysisTest(t, "converters/24-generics/clean")
+	})
+
+	t.Run("24-generics:dirty", func(t *testing.T) {
+		runAnalysisTest(t, "converters/24-generics/dirty",
+			DiagnosticAssertion{
+				FunctionName:  "ConvertUserPage",
+				FieldsMissing: []string{"in.Cursor", "Cursor"},
+			},
+			DiagnosticAssertion{
+				FunctionName:  "WrapEntity",
+				FieldsMissing: []string{"in.ID", "in.Email", "in.Name", "Data"},
+			},
+			DiagnosticAssertion{
+				FunctionName:  "BuildView",
+				FieldsMissing: []string{"in.Name", "Name"},
+			},
+			DiagnosticAssertion{
+				FunctionName:  "ToDTO",
+				FieldsMissing: []string{"in.Email", "Email"},
+			},
+		)
+	})
+}
+
+func TestMultiInput(t *testing.T) {
+	t.Run("25-multi-input:clean", func(t *testing.T) {
+		// Every struct parameter is an input; the output may be filled from any of them.
+		runAnalysisTest(t, "converters/25-multi-input/clean")
+	})
+
+	dirty := []DiagnosticAssertion{
+		{
+			FunctionName:  "ToUserProfile",
+			FieldsMissing: []string{"s.Language", "Language"},
+		},
+		{
+			FunctionName:  "ToUserProfilePartial",
+			FieldsMissing: []string{"u.Name", "s.Theme", "Name", "Theme"},
+		},
+	}
+
+	t.Run("25-multi-input:dirty", func(t *testing.T) {
+		// Each input's unread fields are reported with its own variable prefix.
+		runAnalysisTest(t, "converters/25-multi-input/dirty", dirty...)
+	})
+
+	t.Run("25-multi-input:dirty intersection", func(t *testing.T) {
+		// Output fields only the second input provides are still expected.
+		cfg := config.DefaultConfig()
+		cfg.FieldValidationMode = config.ModeIntersection
+		runAnalysisTestWithConfig(t, "converters/25-multi-input/dirty", cfg, dirty...)
+	})
+}
+
+func TestMultiOutput(t *testing.T) {
+	t.Run("26-multi-output:clean", func(t *testing.T) {
+		// Every struct result is an output: named results, literals per return position
+		// and slices built with append.
+		runAnalysisTest(t, "converters/26-multi-output/clean")
+	})
+
+	t.Run("26-multi-output:dirty", func(t *testing.T) {
+		// Unnamed results are reported under their type name.
+		runAnalysisTest(t, "converters/26-multi-output/dirty",
+			DiagnosticAssertion{
+				FunctionName:  "SplitOrder",
+				FieldsMissing: []string{"LineDTO.Qty"},
+			},
+			DiagnosticAssertion{
+				FunctionName:  "SplitOrderNamed",
+				FieldsMissing: []string{"o.Customer", "dto.Customer"},
+			},
+			DiagnosticAssertion{
+				FunctionName:  "ToOrderDTOs",
+				FieldsMissing: []string{"OrderDTO.Customer"},
+			},
+		)
+	})
+}
+
+func TestReceiverInput(t *testing.T) {
+	t.Run("27-receiver-input:clean", func(t *testing.T) {
+		// Receiver-only methods are converters of their receiver.
+		runAnalysisTest(t, "converters/27-receiver-input/clean")
+	})
+
+	t.Run("27-receiver-input:dirty", func(t *testing.T) {
+		runAnalysisTest(t, "converters/27-receiver-input/dirty",
+			DiagnosticAssertion{
+				FunctionName:  "ToDTO",
+				FieldsMissing: []string{"u.Role", "RoleName"},
+			},
+			DiagnosticAssertion{
+				FunctionName:  "ToDTOPtr",
+				FieldsMissing: []string{"u.Email", "Email"},
+			},
+		)
+	})
+
+	t.Run("27-receiver-input:prefer", func(t *testing.T) {
+		// The receiver takes priority over struct parameters, which become additional inputs.
+		cfg := config.DefaultConfig()
+		cfg.ReceiverInput = config.ReceiverPrefer
+		runAnalysisTestWithConfig(t, "converters/27-receiver-input/prefer", cfg,
+			DiagnosticAssertion{
+				FunctionName:  "ToUserProfile",
+				FieldsMissing: []string{"s.Language", "Language"},
+			},
+		)
+	})
+}
+
+func TestFillTargets(t *testing.T) {
+	t.Run("28-fill-targets:clean", func(t *testing.T) {
+		// Pointer parameters and receivers written through are outputs.
+		runAnalysisTest(t, "converters/28-fill-targets/clean")
+	})
+
+	t.Run("28-fill-targets:dirty", func(t *testing.T) {
+		runAnalysisTest(t, "converters/28-fill-targets/dirty",
+			DiagnosticAssertion{
+				FunctionName:  "fillDTO",
+				FieldsMissing: []string{"src.Name", "dst.Name"},
+			},
+			DiagnosticAssertion{
+				FunctionName:  "applyPatch",
+				FieldsMissing: []string{"patch.Name", "dst.Name"},
+			},
+			DiagnosticAssertion{
+				FunctionName:  "FromDomain",
+				FieldsMissing: []string{"u.Email", "v.Email"},
+			},
+		)
+	})
+
+	t.Run("28-fill-targets:safe fix stubs the target", func(t *testing.T) {
+		cfg := config.DefaultConfig()
+		cfg.FixMode = config.FixModeSafe
+
+		diagnostics := runRawAnalysisTestWithConfig(t, "converters/28-fill-targets/dirty", &cfg)
+		if len(diagnostics) == 0 {
+			t.Fatal("expected diagnostics")
+		}
+		fixes := diagnostics[0].SuggestedFixes
+		if len(fixes) != 1 {
+			t.Fatalf("expected 1 suggested fix, got %d", len(fixes))
+		}
+		var text strings.Builder
+		for _, edit := range fixes[0].TextEdits {
+			text.Write(edit.NewText)
+		}
+		if !strings.Contains(text.String(), "_ = dst.Name") {
+			t.Errorf("expected a stub against the pointer variable, got: %s", text.String())
+		}
+	})
+}
+
+func TestFuncLiterals(t *testing.T) {
+	t.Run("29-func-literals:clean", func(t *testing.T) {
+		// Literals held in variables, tables and call arguments are converters too.
+		runAnalysisTest(t, "converters/29-func-literals/clean")
+	})
+
+	t.Run("29-func-literals:dirty", func(t *testing.T) {
+		runAnalysisTest(t, "converters/29-func-literals/dirty",
+			DiagnosticAssertion{FunctionName: "toDTO", FieldsMissing: []string{"u.Name", "Name"}},
+			DiagnosticAssertion{FunctionName: "converters[KindUser]", FieldsMissing: []string{"u.Name", "Name"}},
+			DiagnosticAssertion{FunctionName: "converters[KindAdmin]", FieldsMissing: []string{"u.Email", "Email"}},
+			DiagnosticAssertion{FunctionName: "handlers.ToDTO", FieldsMissing: []string{"u.ID", "ID"}},
+			DiagnosticAssertion{FunctionName: "func literal passed to mapAll", FieldsMissing: []string{"u.Email", "Email"}},
+			DiagnosticAssertion{FunctionName: "convert", FieldsMissing: []string{"in.Name", "Name"}},
+		)
+	})
+
+	t.Run("29-func-literals:safe fix", func(t *testing.T) {
+		cfg := config.DefaultConfig()
+		cfg.FixMode = config.FixModeSafe
+
+		diagnostics := runRawAnalysisTestWithConfig(t, "converters/29-func-literals/dirty", &cfg)
+		if len(diagnostics) == 0 {
+			t.Fatal("expected diagnostics")
+		}
+		if len(diagnostics[0].SuggestedFixes) != 1 {
+			t.Fatalf("expected 1 suggested fix, got %d", len(diagnostics[0].SuggestedFixes))
+		}
+	})
+}
+
+func TestTypePairs(t *testing.T) {
+	t.Run("30-type-pairs:default", func(t *testing.T) {
+		runAnalysisTest(t, "converters/30-type-pairs/default",
+			DiagnosticAssertion{FunctionName: "ToAPIAccount", FieldsMissing: []string{"a.Email", "Email"}},
+		)
+	})
+
+	t.Run("30-type-pairs:with pairs", func(t *testing.T) {
+		cfg := config.DefaultConfig()
+		cfg.TypePairs = []string{
+			"domain.Account=api.ProfileResponse",
+			"converters/*/domain.Order=api.Inv*",
+			"domain.Account=api.Account",
+		}
+		// Pairs lift the name matching only: min-similarity does not apply to them either.
+		cfg.MinTypeNameSimilarity = 0.9
+		runAnalysisTestWithConfig(t, "converters/30-type-pairs/dirty", cfg,
+			DiagnosticAssertion{FunctionName: "ToProfile", FieldsMissing: []string{"a.Name", "Name"}},
+			DiagnosticAssertion{FunctionName: "ToInvoice", FieldsMissing: []string{"o.Total", "Total"}},
+			DiagnosticAssertion{FunctionName: "ToAPIAccount", FieldsMissing: []string{"a.Email", "Email"}},
+		)
+	})
+}
+
+func TestPackagePairs(t *testing.T) {
+	t.Run("31-package-pairs:default", func(t *testing.T) {
+		// Without package pairs, name overlap inside one package is enough.
+		runAnalysisTest(t, "converters/31-package-pairs/default",
+			DiagnosticAssertion{FunctionName: "ReplyParams", FieldsMissing: []string{"m.ID", "Channel"}},
+		)
+	})
+
+	t.Run("31-package-pairs:dirty", func(t *testing.T) {
+		cfg := config.DefaultConfig()
+		cfg.PackagePairs = []string{"*/domain=*/dto"}
+		cfg.MinTypeNameSimilarity = 0.6
+		runAnalysisTestWithConfig(t, "converters/31-package-pairs/dirty", cfg,
+			DiagnosticAssertion{FunctionName: "ToUserDTO", FieldsMissing: []string{"u.Name", "Name"}},
+		)
+	})
+}
+
+func TestNameMatching(t *testing.T) {
+	t.Run("32-name-matching:tokens", func(t *testing.T) {
+		cfg := config.DefaultConfig()
+		cfg.NameMatching = config.NameMatchingTokens
+		runAnalysisTestWithConfig(t, "converters/32-name-matching/dirty", cfg,
+			DiagnosticAssertion{FunctionName: "RecordToDTO", FieldsMissing: []string{"r.Name", "Name"}},
+			DiagnosticAssertion{FunctionName: "FromPB", FieldsMissing: []string{"p.Email", "Email"}},
+		)
+	})
+}
+
+func TestPathSensitive(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.PathSensitive = true
+
+	t.Run("33-path-sensitive:clean", func(t *testing.T) {
+		runAnalysisTestWithConfig(t, "converters/33-path-sensitive/clean", cfg)
+	})
+
+	t.Run("33-path-sensitive:dirty", func(t *testing.T) {
+		runAnalysisTestWithConfig(t, "converters/33-path-sensitive/dirty", cfg,
+			DiagnosticAssertion{FunctionName: "BranchToDTO (return at line 11)", FieldsMissing: []string{"Email"}},
+			DiagnosticAssertion{FunctionName: "VarToDTO (return at line 21)", FieldsMissing: []string{"Email"}},
+			DiagnosticAssertion{FunctionName: "VarToDTO (return at line 24)", FieldsMissing: []string{"Name"}},
+			DiagnosticAssertion{FunctionName: "NamedToDTO (return at line 34)", FieldsMissing: []string{"dto.Email"}},
+			DiagnosticAssertion{FunctionName: "MissingEverywhereToDTO", FieldsMissing: []string{"u.Email", "Email"}},
+			DiagnosticAssertion{FunctionName: "MissingEverywhereToDTO (return at line 41)", FieldsMissing: []string{"Name"}},
+			DiagnosticAssertion{FunctionName: "ErrToDTO (return at line 56)", FieldsMissing: []string{"Email"}},
+		)
+	})
+
+	// Merged over the body, every output field is set.
+	t.Run("33-path-sensitive:default", func(t *testing.T) {
+		runAnalysisTest(t, "converters/33-path-sensitive/clean")
+	})
+}
+
+func TestSetters(t *testing.T) {
+	t.Run("34-setters:setters", func(t *testing.T) {
+		runAnalysisTest(t, "converters/34-setters/setters",
+			DiagnosticAssertion{FunctionName: "ToPBMissing", FieldsMissing: []string{"u.Email", "Email"}},
+			DiagnosticAssertion{FunctionName: "fillPB", FieldsMissing: []string{"u.Email", "dst.Email"}},
+			DiagnosticAssertion{FunctionName: "ToPBInputSetter", FieldsMissing: []string{"u.Email"}},
+		)
+	})
+
+	t.Run("34-setters:builder", func(t *testing.T) {
+		cfg := config.DefaultConfig()
+		cfg.SetterPatterns = []string{"With*"}
+		runAnalysisTestWithConfig(t, "converters/34-setters/builder", cfg,
+			DiagnosticAssertion{FunctionName: "ToBuilderMissing", FieldsMissing: []string{"u.Email", "Email"}},
+		)
+	})
+}
+
+func TestSetters_SmartFixCallsSetter(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.FixMode = "smart"
+
+	diagnostics := runRawAnalysisTestWithConfig(t, "converters/34-setters/setters", &cfg)
+	var text string
+	for _, diag := range diagnostics {
+		if strings.Contains(diag.Message, "ToPBMissing:") && len(diag.SuggestedFixes) > 0 {
+			for _, edit := range diag.SuggestedFixes[0].TextEdits {
+				text += string(edit.NewText)
+			}
+		}
+	}
+	if !strings.Contains(text, "out.SetEmail(u.Email)") {
+		t.Errorf("expected the smart fix to call the setter, got: %q", text)
+	}
+}
+
+func TestHelperCalls(t *testing.T) {
+	t.Run("35-helper-calls:clean", func(t *testing.T) {
+		runAnalysisTest(t, "converters/35-helper-calls/clean")
+	})
+
+	t.Run("35-helper-calls:dirty", func(t *testing.T) {
+		runAnalysisTest(t, "converters/35-helper-calls/dirty",
+			DiagnosticAssertion{FunctionName: "ToDTOMissing", FieldsMissing: []string{"u.Bio", "City"}},
+		)
+	})
+}
+
+func TestFieldUsageFacts(t *testing.T) {
+	t.Run("36-facts:mapping", func(t *testing.T) {
+		runAnalysisTest(t, "converters/36-facts/mapping")
+	})
+
+	t.Run("36-facts:clean", func(t *testing.T) {
+		runAnalysisTest(t, "converters/36-facts/clean")
+	})
+
+	t.Run("36-facts:dirty", func(t *testing.T) {
+		runAnalysisTest(t, "converters/36-facts/dirty",
+			DiagnosticAssertion{FunctionName: "ToDTOMissing", FieldsMissing: []string{"u.Bio", "City"}},
+		)
+	})
+}
+
+func TestDeprecatedFieldFacts(t *testing.T) {
+	t.Run("37-deprecated-facts:clean", func(t *testing.T) {
+		runAnalysisTest(t, "converters/37-deprecated-facts/clean")
+	})
+
+	t.Run("37-deprecated-facts:include", func(t *testing.T) {
+		cfg := config.DefaultConfig()
+		cfg.IncludeDeprecated = true
+		runAnalysisTestWithConfig(t, "converters/37-deprecated-facts/include", cfg,
+			DiagnosticAssertion{FunctionName: "EventToReply", FieldsMissing: []string{"e.OldName", "OldName"}},
+			DiagnosticAssertion{FunctionName: "EventFromPB", FieldsMissing: []string{"in.OldName", "OldName"}},
+			DiagnosticAssertion{FunctionName: "EventPage", FieldsMissing: []string{"in.Rows", "Rows"}},
+		)
+	})
+}
+
+func TestCopies(t *testing.T) {
+	t.Run("38-copies:conversion", func(t *testing.T) {
+		runAnalysisTest(t, "converters/38-copies/conversion")
+	})
+
+	t.Run("38-copies:match", func(t *testing.T) {
+		runAnalysisTest(t, "converters/38-copies/copies",
+			DiagnosticAssertion{FunctionName: "ToDTO", FieldsMissing: []string{"u.Login", "Username"}},
+		)
+	})
+
+	t.Run("38-copies:trust", func(t *testing.T) {
+		cfg := config.DefaultConfig()
+		cfg.CopyHandling = config.CopyHandlingTrust
+		runAnalysisTestWithConfig(t, "converters/38-copies/trust", cfg)
+	})
+}
+
+func TestEmbeddedFields(t *testing.T) {
+	t.Run("39-embedded:clean", func(t *testing.T) {
+		runAnalysisTest(t, "converters/39-embedded/clean")
+	})
+
+	t.Run("39-embedded:dirty", func(t *testing.T) {
+		runAnalysisTest(t, "converters/39-embedded/dirty",
+			DiagnosticAssertion{FunctionName: "ToRowMissing", FieldsMissing: []string{"u.DeletedAt", "GormModel.DeletedAt"}},
+			DiagnosticAssertion{FunctionName: "ToRecordMissing", FieldsMissing: []string{
+				"u.Audit.CreatedBy", "u.CreatedAt", "u.UpdatedAt", "u.DeletedAt",
+				"Base.GormModel.CreatedAt", "Base.GormModel.UpdatedAt", "Base.GormModel.DeletedAt", "CreatedBy",
+			}},
+		)
+	})
+
+	// Promoted fields go through exclude-fields one by one, by leaf name or full path.
+	t.Run("39-embedded:exclude-fields", func(t *testing.T) {
+		cfg := config.DefaultConfig()
+		cfg.ExcludeFieldPatterns = []string{"^CreatedAt$", "^Base.GormModel.UpdatedAt$", "CreatedBy"}
+		runAnalysisTestWithConfig(t, "converters/39-embedded/dirty", cfg,
+			DiagnosticAssertion{FunctionName: "ToRowMissing", FieldsMissing: []string{"u.DeletedAt", "GormModel.DeletedAt"}},
+			DiagnosticAssertion{FunctionName: "ToRecordMissing", FieldsMissing: []string{
+				"u.UpdatedAt", "u.DeletedAt", "Base.GormModel.DeletedAt",
+			}},
+		)
+	})
+}
+
+func TestNestedDepth(t *testing.T) {
+	t.Run("40-nested-depth:default", func(t *testing.T) {
+		runAnalysisTest(t, "converters/40-nested-depth/shallow")
+	})
+
+	// One level: Owner, nil-checked and built empty, is checked field by field; Host's
+	// Manager sits a level further down.
+	t.Run("40-nested-depth:one-level", func(t *testing.T) {
+		cfg := config.DefaultConfig()
+		cfg.NestedDepth = 1
+		runAnalysisTestWithConfig(t, "converters/40-nested-depth/dirty", cfg,
+			DiagnosticAssertion{FunctionName: "ConvertEvent", FieldsMissing: []string{
+				"e.Owner.ID", "e.Owner.Name", "e.Owner.Role", "e.Owner.Manager",
+				"Owner.ID", "Owner.Name", "Owner.Role", "Owner.Manager",
+			}},
+		)
+	})
+
+	// Unlimited depth reaches Host.Manager, and still ends on the self-referential User.
+	t.Run("40-nested-depth:unlimited", func(t *testing.T) {
+		cfg := config.DefaultConfig()
+		cfg.NestedDepth = -1
+		runAnalysisTestWithConfig(t, "converters/40-nested-depth/deep", cfg,
+			DiagnosticAssertion{FunctionName: "ConvertEvent", FieldsMissing: []string{
+				"e.Owner.ID", "e.Owner.Name", "e.Owner.Role", "e.Owner.Manager",
+				"Owner.ID", "Owner.Name", "Owner.Role", "Owner.Manager",
+			}},
+			DiagnosticAssertion{FunctionName: "ConvertEventHost", FieldsMissing: []string{
+				"e.Host.Manager.ID", "e.Host.Manager.Name", "e.Host.Manager.Role", "e.Host.Manager.Manager",
+				"Host.Manager.ID", "Host.Manager.Name", "Host.Manager.Role", "Host.Manager.Manager",
+			}},
+		)
+	})
+
+	// Nested structs handed on whole are complete at any depth.
+	t.Run("40-nested-depth:clean", func(t *testing.T) {
+		cfg := config.DefaultConfig()
+		cfg.NestedDepth = -1
+		runAnalysisTestWithConfig(t, "converters/40-nested-depth/clean", cfg)
+	})
+}
+
+func TestNestedConverterCalls(t *testing.T) {
+	t.Run("41-nested-calls:mapping", func(t *testing.T) {
+		runAnalysisTest(t, "converters/41-nested-calls/mapping",
+			DiagnosticAssertion{FunctionName: "ToGroupDTO", FieldsMissing: []string{"g.Title", "Title"}},
+		)
+	})
+
+	t.Run("41-nested-calls:clean", func(t *testing.T) {
+		runAnalysisTest(t, "converters/41-nested-calls/clean")
+	})
+
+	t.Run("41-nested-calls:dirty", func(t *testing.T) {
+		runAnalysisTest(t, "converters/41-nested-calls/dirty",
+			DiagnosticAssertion{FunctionName: "toRoleDTO", FieldsMissing: []string{"r.Name", "Name"}},
+			DiagnosticAssertion{FunctionName: "ConvertUser", FieldsMissing: []string{"u.Name", "Name"}},
+			DiagnosticAssertion{FunctionName: "ConvertUserFields", FieldsMissing: []string{"u.Name", "Name"}},
+			DiagnosticAssertion{FunctionName: "ToCategoryDTO", FieldsMissing: []string{"c.Name", "Name"}},
+		)
+	})
+
+	t.Run("41-nested-calls:related", func(t *testing.T) {
+		diagnostics := runRawAnalysisTestWithConfig(t, "converters/41-nested-calls/dirty", nil)
+		var related []string
+		for _, d := range diagnostics {
+			if strings.Contains(d.Message, "ConvertUser: ") {
+				for _, r := range d.Related {
+					related = append(related, r.Message)
+				}
+			}
+		}
+		want := []string{
+			"toRoleDTO: incomplete converter with missing fields: r.Name, Name",
+			"mapping.ToGroupDTO: incomplete converter with missing fields: g.Title, Title",
+			"mapping.NewStreetAddressDTO: not a converter",
+		}
+		if !fieldsMatch(related, want) {
+			t.Errorf("related information mismatch\n  Expected: %v\n  Got: %v", want, related)
+		}
+	})
+}
+
+func TestDataflow(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Dataflow = true
+
+	t.Run("42-dataflow:clean", func(t *testing.T) {
+		runAnalysisTestWithConfig(t, "converters/42-dataflow/clean", cfg)
+	})
+
+	t.Run("42-dataflow:dirty", func(t *testing.T) {
+		runAnalysisTestWithConfig(t, "converters/42-dataflow/dirty", cfg,
+			DiagnosticAssertion{FunctionName: "ToUserDTOPlaceholder", FieldsMissing: []string{}},
+			DiagnosticAssertion{FunctionName: "ToUserDTOEmptyRole", FieldsMissing: []string{}},
+			DiagnosticAssertion{FunctionName: "ToUserDTOMissing", FieldsMissing: []string{"u.Active", "out.Status"}},
+		)
+	})
+}
+
+func TestSuspiciousMappings(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.SuspiciousMappings = true
+
+	t.Run("43-suspicious-mappings:clean", func(t *testing.T) {
+		runAnalysisTestWithConfig(t, "converters/43-suspicious-mappings/clean", cfg)
+	})
+
+	t.Run("43-suspicious-mappings:dirty", func(t *testing.T) {
+		runAnalysisTestWithConfig(t, "converters/43-suspicious-mappings/dirty", cfg,
+			DiagnosticAssertion{FunctionName: "ToPersonDTOMismatch", FieldsMissing: []string{"p.Email"}},
+			DiagnosticAssertion{FunctionName: "ToPersonDTOSwapped", FieldsMissing: []string{}},
+			DiagnosticAssertion{FunctionName: "FillPersonDTOSwapped", FieldsMissing: []string{}},
+			DiagnosticAssertion{FunctionName: "ToPersonDTOMismatch", FieldsMissing: []string{}},
+		)
+	})
+}
+
+func TestOverwrittenFields(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.OverwrittenFields = true
+
+	t.Run("44-overwritten-fields:clean", func(t *testing.T) {
+		runAnalysisTestWithConfig(t, "converters/44-overwritten-fields/clean", cfg)
+	})
+
+	t.Run("44-overwritten-fields:dirty", func(t *testing.T) {
+		runAnalysisTestWithConfig(t, "converters/44-overwritten-fields/dirty", cfg,
+			DiagnosticAssertion{FunctionName: "ToOrderDTOCopyPaste", FieldsMissing: []string{"Email"}},
+			DiagnosticAssertion{FunctionName: "ToOrderDTOCopyPaste", FieldsMissing: []string{}},
+			DiagnosticAssertion{FunctionName: "ToOrderDTOLiteral", FieldsMissing: []string{}},
+			DiagnosticAssertion{FunctionName: "FillOrderDTO", FieldsMissing: []string{}},
+			DiagnosticAssertion{FunctionName: "ToOrderDTOShadowed", FieldsMissing: []string{}},
+			DiagnosticAssertion{FunctionName: "ToOrderDTOAlias", FieldsMissing: []string{}},
+		)
+	})
+
+	t.Run("related information points at each write", func(t *testing.T) {
+		diagnostics := runRawAnalysisTestWithConfig(t, "converters/44-overwritten-fields/dirty", &cfg)
+
+		var fill *analysis.Diagnostic
+		for i, d := range diagnostics {
+			if strings.HasPrefix(d.Message, "FillOrderDTO: ") {
+				fill = &diagnostics[i]
+			}
+		}
+		if fill == nil {
+			t.Fatal("no diagnostic for FillOrderDTO")
+		}
+		// The first assignment, then the one of each branch.
+		if len(fill.Related) != 3 {
+			t.Fatalf("expected 3 related positions, got %d: %+v", len(fill.Related), fill.Related)
+		}
+		if fill.Related[0].Pos != fill.Pos {
+			t.Errorf("first related position should be the overwritten assignment")
+		}
+		for i, msg := range []string{
+			"dst.Status set here, never used",
+			"dst.Status set again here",
+			"dst.Status set again here",
+		} {
+			if fill.Related[i].Message != msg {
+				t.Errorf("related %d: got %q, want %q", i, fill.Related[i].Message, msg)
+			}
+		}
+	})
+}
+
+func TestShadowing(t *testing.T) {
+	t.Run("45-shadowing:clean", func(t *testing.T) {
+		runAnalysisTest(t, "converters/45-shadowing/clean")
+	})
+
+	t.Run("45-shadowing:dirty", func(t *testing.T) {
+		runAnalysisTest(t, "converters/45-shadowing/dirty",
+			DiagnosticAssertion{FunctionName: "ToUserDTOClosure", FieldsMissing: []string{"u.Email"}},
+			DiagnosticAssertion{FunctionName: "ToUserDTOShadowed", FieldsMissing: []string{"u.Email"}},
+			DiagnosticAssertion{FunctionName: "ToUserDTOShadowedOutput", FieldsMissing: []string{"Email"}},
+			DiagnosticAssertion{
+				FunctionName:  "ToUserDTOForwardShadowed",
+				FieldsMissing: []string{"u.Email", "ID", "Name", "Email"},
+			},
+			DiagnosticAssertion{FunctionName: "ToUserDTOConvertShadowed", FieldsMissing: []string{"u.Email", "Email"}},
+			DiagnosticAssertion{FunctionName: "ToUserDTOsRangeShadowed", FieldsMissing: []string{"users.Email", "Email"}},
+			DiagnosticAssertion{
+				FunctionName:  "ToUserDTOGenericShadowed",
+				FieldsMissing: []string{"u.ID", "u.Name", "u.Email"},
+			},
+			DiagnosticAssertion{FunctionName: "fillRest", FieldsMissing: []string{"u.ID", "dst.ID"}},
+			DiagnosticAssertion{FunctionName: "ToUserDTOHelperShadowed", FieldsMissing: []string{"Email"}},
+		)
+	})
+}
+
+func TestAliases(t *testing.T) {
+	t.Run("46-aliases:clean", func(t *testing.T) {
+		runAnalysisTest(t, "converters/46-aliases/clean")
+	})
+
+	t.Run("46-aliases:dirty", func(t *testing.T) {
+		runAnalysisTest(t, "converters/46-aliases/dirty",
+			DiagnosticAssertion{FunctionName: "ToOrderDTOInputAlias", FieldsMissing: []string{"in.User.Email"}},
+			DiagnosticAssertion{FunctionName: "ToUserDTONestedAlias", FieldsMissing: []string{"u.Role.Name", "Role.Name"}},
+			DiagnosticAssertion{
+				FunctionName:  "ToOrderDTOOutputAlias",
+				FieldsMissing: []string{"in.Shipping.Street", "Shipping.Street"},
+			},
+			DiagnosticAssertion{FunctionName: "ToOrderDTOCopiedOutput", FieldsMissing: []string{"Shipping.Street"}},
+		)
+	})
+}
+
 func TestFixSafe(t *testing.T) {
 	cfg := config.DefaultConfig()
 	cfg.FixMode = "safe"
@@ -3,6 +3,8 @@ package lf
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
+	"slices"
 	"strings"
 )
 
@@ -33,22 +35,35 @@ func (l UsageLookup) Add(k string) {
 // UsageCollector is a generic AST visitor that collects selector usage for a given variable.
 // rType(RecordingType) stands for the type of things we record: fields or methods.
 // It now tracks nested field accesses (e.g., event.User.Role.Name) by recording the full chain.
+// With type information, the variable is told by its object rather than its name (see
+// resolveVar), so a closure parameter or a variable shadowing it is not mistaken for it.
 type UsageCollector struct {
 	used        UsageLookup
 	varName     string
+	info        *types.Info
+	target      varTarget
 	parentStack []ast.Node
 	nodesType   CollectingType
 }
 
-func NewUsageCollector(varName string, rType CollectingType) *UsageCollector {
+// NewUsageCollector returns a collector of the rType usage of varName. info may be nil,
+// leaving the variable to be told by name alone.
+func NewUsageCollector(info *types.Info, varName string, rType CollectingType) *UsageCollector {
 	return &UsageCollector{
 		used:        make(UsageLookup),
 		varName:     varName,
+		info:        info,
 		parentStack: make([]ast.Node, 0),
 		nodesType:   rType,
 	}
 }
 
+// newChainCollector returns a collector whose buildFieldChain follows varName as
+// resolved in n.
+func newChainCollector(info *types.Info, n ast.Node, varName string) *UsageCollector {
+	return &UsageCollector{varName: varName, info: info, target: resolveVar(info, n, varName)}
+}
+
 // Visit collects used items (of nodeType) in a given container node.
 func (v *UsageCollector) Visit(container ast.Node) ast.Visitor {
 	// When node is nil, we're returning from a branch: pop the last parent.
@@ -70,11 +85,15 @@ func (v *UsageCollector) Visit(container ast.Node) ast.Visitor {
 
 	// Build the chain of field accesses starting from varName.
 	// For example, in "event.User.Role.Name", this will record "User", "User.Role", and "User.Role.Name"
-	fieldChain := v.buildFieldChain(sel)
+	fieldChain, shared := v.fieldChain(sel)
 	if fieldChain == "" {
 		// Not a direct access to varName
 		return v
 	}
+	if !shared && isAssignTarget(v.parentStack) {
+		// Writing to a copy of a field of varName does not touch varName
+		return v
+	}
 
 	// Determine whether this selector is used as part of a call expression.
 	var isMethodCall bool
@@ -108,15 +127,31 @@ func (v *UsageCollector) Visit(container ast.Node) ast.Visitor {
 }
 
 func (v *UsageCollector) Walk(container ast.Node) UsageLookup {
+	return v.walk(container, resolveVar(v.info, container, v.varName))
+}
+
+// walk is Walk following target, the variable as already resolved: a statement of a
+// converter is walked for its output as resolved over the whole body.
+func (v *UsageCollector) walk(container ast.Node, target varTarget) UsageLookup {
 	v.reset()
+	v.target = target
 	ast.Walk(v, container)
 	return v.used
 }
 
 // buildFieldChain builds a chain of field accesses from a SelectorExpr.
 // For example, given event.User.Role.Name, it returns "User.Role.Name" (without the varName prefix).
+// A chain through an alias of varName extends the alias' own: u.Name after u := event.User
+// is "User.Name".
 // Returns empty string if the selector chain doesn't start with varName.
 func (v *UsageCollector) buildFieldChain(sel *ast.SelectorExpr) string {
+	chain, _ := v.fieldChain(sel)
+	return chain
+}
+
+// fieldChain is buildFieldChain also telling whether the chain reaches varName's own
+// value: false through an alias holding a copy (a := out.Address), where writes are lost.
+func (v *UsageCollector) fieldChain(sel *ast.SelectorExpr) (string, bool) {
 	var chain []string
 
 	// Walk up the selector chain and collect field names
@@ -127,12 +162,15 @@ func (v *UsageCollector) buildFieldChain(sel *ast.SelectorExpr) string {
 		switch x := unwrapBase(current.X).(type) {
 		case *ast.Ident:
 			// We've reached the base variable
-			if x.Name == v.varName {
+			if v.target.is(x) {
 				// Successfully traced back to varName - return the full chain
-				return strings.Join(chain, ".")
+				return strings.Join(chain, "."), true
+			}
+			if a, ok := v.target.aliasOf(x); ok {
+				return joinPath(a.chain, strings.Join(chain, ".")), a.shared
 			}
 			// Not our variable
-			return ""
+			return "", false
 
 		case *ast.SelectorExpr:
 			// Continue walking up the chain
@@ -140,11 +178,11 @@ func (v *UsageCollector) buildFieldChain(sel *ast.SelectorExpr) string {
 
 		default:
 			// Some other expression type we don't handle
-			return ""
+			return "", false
 		}
 	}
 
-	return ""
+	return "", false
 }
 
 func (v *UsageCollector) reset() {
@@ -171,16 +209,89 @@ func unwrapBase(expr ast.Expr) ast.Expr {
 	}
 }
 
+// isAssignTarget reports whether the last node of stack, a selector, is assigned to or
+// selects into what is (a.City = ..., a.City.Name++, a.Tags[0] = ...).
+func isAssignTarget(stack []ast.Node) bool {
+	for i := len(stack) - 1; i > 0; i-- {
+		switch parent := stack[i-1].(type) {
+		case *ast.SelectorExpr, *ast.StarExpr, *ast.ParenExpr:
+			continue
+		case *ast.IndexExpr:
+			if parent.X != stack[i] {
+				return false
+			}
+		case *ast.AssignStmt:
+			return slices.Contains(parent.Lhs, stack[i].(ast.Expr))
+		case *ast.IncDecStmt:
+			return true
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// writesThrough reports whether n assigns through the pointer target: to one of its
+// fields (p.Name = ..., p.Role.Name += ...) or to the value it points to (*p = T{...}).
+// Reassigning the pointer itself (p = &T{}) is not a write through it.
+func writesThrough(n ast.Node, target varTarget) bool {
+	var found bool
+	ast.Inspect(n, func(n ast.Node) bool {
+		var lhs []ast.Expr
+		switch stmt := n.(type) {
+		case *ast.AssignStmt:
+			lhs = stmt.Lhs
+		case *ast.IncDecStmt:
+			lhs = []ast.Expr{stmt.X}
+		}
+		for _, expr := range lhs {
+			if isWriteThrough(expr, target) {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// isWriteThrough reports whether the assignment target expr is reached through target
+// by at least one field selection or dereference.
+func isWriteThrough(expr ast.Expr, target varTarget) bool {
+	through := false
+	for {
+		switch x := expr.(type) {
+		case *ast.SelectorExpr:
+			expr, through = x.X, true
+		case *ast.StarExpr:
+			expr, through = x.X, true
+		case *ast.IndexExpr:
+			expr = x.X
+		case *ast.ParenExpr:
+			expr = x.X
+		case *ast.Ident:
+			return through && target.is(x)
+		default:
+			return false
+		}
+	}
+}
+
 // CollectUsedFields walks the AST rooted at n and returns a set (UsageLookup)
 // of field names that are directly accessed on varName (ignoring any method calls).
-func CollectUsedFields(n ast.Node, varName string) UsageLookup {
-	return NewUsageCollector(varName, RecordFields).Walk(n)
+// varName is resolved through info (see resolveVar); a nil info matches it by name.
+func CollectUsedFields(info *types.Info, n ast.Node, varName string) UsageLookup {
+	return NewUsageCollector(info, varName, RecordFields).Walk(n)
 }
 
 // CollectUsedMethods walks the AST rooted at n and returns a set (UsageLookup)
 // of method names that are called on varName.
-func CollectUsedMethods(n ast.Node, varName string) UsageLookup {
-	return NewUsageCollector(varName, RecordMethods).Walk(n)
+func CollectUsedMethods(info *types.Info, n ast.Node, varName string) UsageLookup {
+	return NewUsageCollector(info, varName, RecordMethods).Walk(n)
+}
+
+// collectUsage returns the rType usage of target in n, target being already resolved.
+func collectUsage(n ast.Node, target varTarget, rType CollectingType) UsageLookup {
+	return NewUsageCollector(target.info, target.name, rType).walk(n, target)
 }
 
 // unwrapCompositeLit returns the composite literal in expr, unwrapping the
@@ -211,6 +322,10 @@ func typeExprName(expr ast.Expr) string {
 		return typeExprName(t.X)
 	case *ast.ParenExpr:
 		return typeExprName(t.X)
+	case *ast.IndexExpr: // Page[UserDTO]
+		return typeExprName(t.X)
+	case *ast.IndexListExpr: // Pair[User, Role]
+		return typeExprName(t.X)
 	}
 	return ""
 }
@@ -241,47 +356,156 @@ func compositeLitOf(expr ast.Expr, candidateName string) *ast.CompositeLit {
 //	    field accesses on that variable (e.g. out.ID = ...).
 //	(b) It scans assignment and return statements for composite literals that initialize a value
 //	    of type candidateName (e.g. out = &Category{ Type: ... }).
-func CollectOutputFields(fn *ast.FuncDecl, outVar, candidateName string) UsageLookup {
+//
+// Calls on the output variable matching setterPatterns count as writes too (see
+// CollectSetterFields). The output variable is resolved through info, like in
+// CollectUsedFields.
+func CollectOutputFields(
+	info *types.Info,
+	fn *ast.FuncDecl,
+	outVar, candidateName string,
+	setterPatterns []string,
+) UsageLookup {
+	return CollectResultFields(info, fn, outVar, candidateName, -1, setterPatterns)
+}
+
+// CollectResultFields is CollectOutputFields for one result of a multi-output converter:
+// in a return statement listing several values, only the one at resultIndex builds this
+// output. A negative resultIndex accepts any position.
+func CollectResultFields(
+	info *types.Info,
+	fn *ast.FuncDecl,
+	outVar, candidateName string,
+	resultIndex int,
+	setterPatterns []string,
+) UsageLookup {
 	ul := make(UsageLookup)
+	outVar = outputVariable(fn, outVar, candidateName)
 
-	// If no output variable was provided (e.g. unnamed result), try to find a local candidate.
+	// (a) If we have an output variable, collect direct field accesses and setter calls.
+	if outVar != "" {
+		for k := range CollectUsedFields(info, fn.Body, outVar) {
+			ul.Add(k)
+		}
+		for k := range CollectSetterFields(info, fn.Body, outVar, setterPatterns) {
+			ul.Add(k)
+		}
+	}
+
+	// (b) Scan the function body for composite literals in assignments and return statements,
+	// extracting their keys (including nested ones).
+	for _, cl := range outputCompositeLitsAt(fn, candidateName, resultIndex) {
+		extractKeysFromCompositeLit(cl, ul)
+	}
+
+	return ul
+}
+
+// extractAssignedLiteralKeys adds the keys of the literals assigned to field chains of
+// varName in n, under the chain: out.Owner = &UserDTO{ID: ...} sets Owner.ID.
+func extractAssignedLiteralKeys(info *types.Info, n ast.Node, varName string, keys UsageLookup) {
+	chains := newChainCollector(info, n, varName)
+	ast.Inspect(n, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok || len(assign.Lhs) != len(assign.Rhs) {
+			return true
+		}
+		for i, lhs := range assign.Lhs {
+			sel, isSel := lhs.(*ast.SelectorExpr)
+			if !isSel {
+				continue
+			}
+			if chain, shared := chains.fieldChain(sel); chain != "" && shared {
+				extractKeysFromValueWithPrefix(assign.Rhs[i], keys, chain)
+			}
+		}
+		return true
+	})
+}
+
+// outputVariable returns the variable holding the output: outVar when the result is
+// named, otherwise a local declared with a literal of candidateName (out := &T{}), or one
+// pre-allocated with make (out := make([]T, len(in))) and filled through the index.
+// Returns "" when there is none.
+func outputVariable(fn *ast.FuncDecl, outVar, candidateName string) string {
 	if outVar == "" {
 		outVar = findLocalCandidateVariable(fn, candidateName)
 	}
-	// A converter that pre-allocates its result (out := make([]T, len(in))) and fills it
-	// through the index has no composite literal to find, so resolve the variable from make.
 	if outVar == "" {
 		outVar = findLocalCollectionVariable(fn, candidateName)
 	}
+	return outVar
+}
 
-	// (a) If we have an output variable, collect direct field accesses.
-	if outVar != "" {
-		for k := range CollectUsedFields(fn.Body, outVar) {
-			ul.Add(k)
-		}
-	}
+// outputCompositeLits returns the composite literals of type candidateName that fn assigns
+// or returns: the literals that build the converter's output.
+func outputCompositeLits(fn *ast.FuncDecl, candidateName string) []*ast.CompositeLit {
+	return outputCompositeLitsAt(fn, candidateName, -1)
+}
 
-	// (b) Scan the function body for composite literals in assignments and return statements.
-	ast.Inspect(fn.Body, func(n ast.Node) bool {
+// outputCompositeLitsAt is outputCompositeLits restricted to the result at resultIndex in
+// return statements listing several values (any position when resultIndex is negative).
+func outputCompositeLitsAt(fn *ast.FuncDecl, candidateName string, resultIndex int) []*ast.CompositeLit {
+	var lits []*ast.CompositeLit
+	ast.PreorderStack(fn.Body, nil, func(n ast.Node, stack []ast.Node) bool {
+		var exprs []ast.Expr
 		switch stmt := n.(type) {
 		case *ast.AssignStmt:
-			for _, expr := range stmt.Rhs {
-				extractKeysFromExpr(expr, candidateName, ul)
-			}
+			exprs = stmt.Rhs
 		case *ast.ReturnStmt:
-			for _, expr := range stmt.Results {
-				extractKeysFromExpr(expr, candidateName, ul)
+			// A return inside a function literal returns from the literal, which is
+			// analyzed on its own (see literalDecls).
+			if insideFuncLit(stack) {
+				return true
+			}
+			exprs = stmt.Results
+			if resultIndex >= 0 && len(exprs) > 1 {
+				if resultIndex >= len(exprs) {
+					return true
+				}
+				exprs = exprs[resultIndex : resultIndex+1]
 			}
 		}
+		for _, expr := range exprs {
+			lits = append(lits, outputCompositeLitsOf(expr, candidateName)...)
+		}
 		return true
 	})
+	return lits
+}
 
-	return ul
+// insideFuncLit reports whether a node whose ancestors are stack sits in a function literal.
+func insideFuncLit(stack []ast.Node) bool {
+	return slices.ContainsFunc(stack, func(n ast.Node) bool {
+		_, ok := n.(*ast.FuncLit)
+		return ok
+	})
+}
+
+// outputCompositeLitsOf examines expr and returns the composite literals it builds of
+// type candidateName: the value itself, or the elements appended to a result slice
+// (lines = append(lines, LineDTO{...})).
+func outputCompositeLitsOf(expr ast.Expr, candidateName string) []*ast.CompositeLit {
+	if call, ok := expr.(*ast.CallExpr); ok {
+		if ident, okFun := call.Fun.(*ast.Ident); okFun && ident.Name == "append" && len(call.Args) > 1 {
+			var lits []*ast.CompositeLit
+			for _, arg := range call.Args[1:] {
+				if cl := outputCompositeLitOf(arg, candidateName); cl != nil {
+					lits = append(lits, cl)
+				}
+			}
+			return lits
+		}
+	}
+	if cl := outputCompositeLitOf(expr, candidateName); cl != nil {
+		return []*ast.CompositeLit{cl}
+	}
+	return nil
 }
 
-// extractKeysFromExpr examines expr and, if it is or contains a composite literal
-// that initializes a value of type candidateName, it extracts any key names (including nested ones) and adds them to keys.
-func extractKeysFromExpr(expr ast.Expr, candidateName string, keys UsageLookup) {
+// outputCompositeLitOf examines expr and returns the composite literal it builds of type
+// candidateName, if any.
+func outputCompositeLitOf(expr ast.Expr, candidateName string) *ast.CompositeLit {
 	// Handle cases like: return (&Category{...}).MethodCall()
 	// The composite literal is in the receiver of the call.
 	if call, ok := expr.(*ast.CallExpr); ok {
@@ -295,13 +519,7 @@ func extractKeysFromExpr(expr ast.Expr, candidateName string, keys UsageLookup)
 		}
 	}
 
-	cl := compositeLitOf(expr, candidateName)
-	if cl == nil {
-		return
-	}
-
-	// Extract keys from key-value pairs, including nested ones
-	extractKeysFromCompositeLit(cl, keys)
+	return compositeLitOf(expr, candidateName)
 }
 
 // extractKeysFromCompositeLit recursively extracts all keys from a composite literal,
@@ -0,0 +1,108 @@
+package lf
+
+import (
+	"go/ast"
+	"go/types"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+
+	"github.com/amberpixels/lostfield/internal/config"
+)
+
+// convertsWholeInput reports whether fn converts its input to the output type in one
+// conversion: UserDTO(u), (*UserDTO)(u), *out = UserDTO(*in). Go only converts between
+// structs of identical fields, so such a conversion maps every one of them.
+func convertsWholeInput(fn *ast.FuncDecl, pass *analysis.Pass, inVar string, out candidate) bool {
+	if out.containerType != ContainerNone && out.containerType != ContainerPointer {
+		return false
+	}
+	input := resolveVar(pass.TypesInfo, fn.Body, inVar)
+	var found bool
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 || !input.ref(call.Args[0]) {
+			return !found
+		}
+		if tv, isType := pass.TypesInfo.Types[call.Fun]; isType && tv.IsType() {
+			found = isStructOf(tv.Type, out.structType)
+		}
+		return !found
+	})
+	return found
+}
+
+// reflectiveCopy returns the call in fn that copies its input into its output through
+// one of the copy-functions - copier.Copy(&out, &in), mapstructure.Decode(in, &out) -
+// and the qualified name to report it under ("copier.Copy"), or nil when there is none.
+// The output is recognized by its type, so "var out UserDTO" works as well as a literal.
+func reflectiveCopy(
+	fn *ast.FuncDecl,
+	pass *analysis.Pass,
+	cfg *config.Config,
+	inVar string,
+	out candidate,
+) (*ast.CallExpr, string) {
+	if len(cfg.CopyFunctions) == 0 {
+		return nil, ""
+	}
+	input := resolveVar(pass.TypesInfo, fn.Body, inVar)
+	var found *ast.CallExpr
+	var name string
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || found != nil {
+			return found == nil
+		}
+		callee := typeutil.StaticCallee(pass.TypesInfo, call)
+		if callee == nil || callee.Pkg() == nil || callee.Signature().Recv() != nil ||
+			!slices.Contains(cfg.CopyFunctions, callee.Pkg().Path()+"."+callee.Name()) {
+			return true
+		}
+		var hasIn, hasOut bool
+		for _, arg := range call.Args {
+			switch {
+			case input.ref(arg):
+				hasIn = true
+			case isStructOf(pass.TypesInfo.TypeOf(arg), out.structType):
+				hasOut = true
+			}
+		}
+		if hasIn && hasOut {
+			found, name = call, callee.Pkg().Name()+"."+callee.Name()
+		}
+		return found == nil
+	})
+	return found, name
+}
+
+// copiedFields returns the fields of st a reflective copy fills from, or into, other:
+// those with a field of the same name there, compared case-insensitively the way the
+// copy libraries pair them.
+func copiedFields(st, other *types.Struct) UsageLookup {
+	copied := make(UsageLookup)
+	for field := range st.Fields() {
+		for o := range other.Fields() {
+			if strings.EqualFold(field.Name(), o.Name()) {
+				copied.Add(field.Name())
+				break
+			}
+		}
+	}
+	return copied
+}
+
+// isStructOf reports whether t, or what it points to, has st as its underlying struct.
+// Tags are ignored, as in a conversion: `json:"id"` on one side and `db:"id"` on the
+// other still hold the same fields.
+func isStructOf(t types.Type, st *types.Struct) bool {
+	if t == nil || st == nil {
+		return false
+	}
+	if ptr, ok := types.Unalias(t).(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	return types.IdenticalIgnoreTags(t.Underlying(), st)
+}
@@ -0,0 +1,587 @@
+package lf
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"maps"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ssa"
+
+	"github.com/amberpixels/lostfield/internal/config"
+)
+
+// flowSource tells where a value comes from, as a set: an input of the converter,
+// anything else that is not a constant (a call, a global, another parameter), or
+// neither - a constant or a zero value.
+type flowSource uint8
+
+const (
+	fromInput flowSource = 1 << iota
+	fromOther
+)
+
+// valueFlow follows, in dataflow mode, the values of a converter through its SSA form:
+// whether what is stored in each output field derives from an input, and whether what
+// is read from each input field reaches the output. Memory is tracked per allocation,
+// not per field: a local temporary carries whatever was stored in any of its fields.
+type valueFlow struct {
+	fn *ssa.Function
+	// inputs holds, per input name, its parameter and the local copy SSA spills it to
+	// when its address is taken.
+	inputs map[string][]ssa.Value
+	// outputs holds, per output, the values its struct is built in: the allocation
+	// returned or the pointer filled, and the allocations copied into those whole.
+	outputs []map[ssa.Value]bool
+	// positions holds the result indexes of the outputs returned.
+	positions []int
+
+	sources    map[ssa.Value]flowSource
+	reaches    map[ssa.Value]bool
+	controlled map[*ssa.BasicBlock]bool
+}
+
+// valueFlow returns the value flow of fn, or nil when dataflow mode is off or fn has no
+// SSA form. Only plain and pointer structs are followed: inputs and outputs in slices
+// or maps are left to the usage checks.
+func (h *helperSummaries) valueFlow(
+	fn *ast.FuncDecl,
+	sig *types.Signature,
+	inputs, outputs []candidateParam,
+) *valueFlow {
+	if h.ssa == nil {
+		return nil
+	}
+	f := h.ssaFunc(fn.Body)
+	if f == nil || len(f.Blocks) == 0 {
+		return nil
+	}
+	vf := &valueFlow{
+		fn:         f,
+		inputs:     make(map[string][]ssa.Value),
+		outputs:    make([]map[ssa.Value]bool, len(outputs)),
+		sources:    make(map[ssa.Value]flowSource),
+		reaches:    make(map[ssa.Value]bool),
+		controlled: make(map[*ssa.BasicBlock]bool),
+	}
+	for _, in := range inputs {
+		p := ssaParam(f, in.name)
+		if p == nil || !isPlainCandidate(in.cand) {
+			continue
+		}
+		vf.inputs[in.name] = append(vf.inputs[in.name], p)
+		for _, ref := range *p.Referrers() {
+			if st, ok := ref.(*ssa.Store); ok && st.Val == p {
+				if alloc, isAlloc := st.Addr.(*ssa.Alloc); isAlloc {
+					vf.inputs[in.name] = append(vf.inputs[in.name], alloc)
+				}
+			}
+		}
+	}
+
+	if positions := resultPositions(sig); len(positions) == len(outputs) {
+		vf.positions = positions
+	}
+	for i, out := range outputs {
+		vf.outputs[i] = make(map[ssa.Value]bool)
+		if !isPlainCandidate(out.cand) {
+			continue
+		}
+		if vf.positions == nil {
+			if p := ssaParam(f, out.name); p != nil {
+				vf.addOutput(i, p)
+			}
+			continue
+		}
+		for _, b := range f.Blocks {
+			if ret, ok := b.Instrs[len(b.Instrs)-1].(*ssa.Return); ok && vf.positions[i] < len(ret.Results) {
+				vf.addOutput(i, ret.Results[vf.positions[i]])
+			}
+		}
+	}
+	return vf
+}
+
+// addOutput records v as memory output i is built in, following what v is made of: the
+// allocation a returned *t0 loads, each edge of a phi, and the allocations stored whole
+// into v (*out = UserDTO{...}, result = UserDTO{...}).
+func (vf *valueFlow) addOutput(i int, v ssa.Value) {
+	if v == nil || vf.outputs[i][v] {
+		return
+	}
+	vf.outputs[i][v] = true
+	switch x := v.(type) {
+	case *ssa.Phi:
+		for _, e := range x.Edges {
+			vf.addOutput(i, e)
+		}
+	case *ssa.UnOp:
+		if x.Op == token.MUL {
+			vf.addOutput(i, x.X)
+		}
+	}
+	if v.Referrers() == nil {
+		return
+	}
+	for _, ref := range *v.Referrers() {
+		st, ok := ref.(*ssa.Store)
+		if !ok || st.Addr != v {
+			continue
+		}
+		if load, isLoad := st.Val.(*ssa.UnOp); isLoad && load.Op == token.MUL {
+			vf.addOutput(i, load.X)
+		} else if _, isPtr := st.Val.Type().Underlying().(*types.Pointer); isPtr {
+			vf.addOutput(i, st.Val)
+		}
+	}
+}
+
+// isOutput reports whether v is memory of some output.
+func (vf *valueFlow) isOutput(v ssa.Value) bool {
+	return slices.ContainsFunc(vf.outputs, func(m map[ssa.Value]bool) bool { return m[v] })
+}
+
+// constantFields returns the top-level fields of output i that are stored to, and only
+// ever from constants or zero values: Email: "", out.Role = RoleDTO{}. A constant chosen
+// by a branch on an input (if in.Active { out.Status = "active" }) derives from it. An
+// output handed to a call (a helper, a setter) may be filled there, so it has none.
+func (vf *valueFlow) constantFields(i int) []string {
+	written := make(map[string]flowSource)
+	for root := range vf.outputs[i] {
+		st := pointedStruct(root.Type())
+		if st == nil || root.Referrers() == nil {
+			continue
+		}
+		for _, ref := range *root.Referrers() {
+			switch r := ref.(type) {
+			case *ssa.FieldAddr:
+				if src, ok := vf.writes(r, make(map[ssa.Value]bool)); ok {
+					written[st.Field(r.Field).Name()] |= src
+				}
+			case ssa.CallInstruction:
+				return nil
+			case *ssa.Store:
+				if r.Val == root {
+					return nil
+				}
+			}
+		}
+	}
+	var res []string
+	for name, src := range written {
+		if src == 0 {
+			res = append(res, name)
+		}
+	}
+	slices.Sort(res)
+	return res
+}
+
+// writes returns where the values written through addr come from, and whether any are:
+// stores to it and to the fields and elements below it, and map updates. A call
+// handed addr may write anything.
+func (vf *valueFlow) writes(addr ssa.Value, seen map[ssa.Value]bool) (flowSource, bool) {
+	if seen[addr] || addr.Referrers() == nil {
+		return 0, false
+	}
+	seen[addr] = true
+	var src flowSource
+	written := false
+	for _, ref := range *addr.Referrers() {
+		switch r := ref.(type) {
+		case *ssa.Store:
+			if r.Addr == addr {
+				s := vf.source(r.Val)
+				if s == 0 && vf.isControlled(r.Block()) {
+					s = fromInput
+				}
+				src |= s
+				written = true
+			}
+		case *ssa.MapUpdate:
+			if r.Map == addr {
+				src |= vf.source(r.Key) | vf.source(r.Value)
+				written = true
+			}
+		case *ssa.FieldAddr, *ssa.IndexAddr, *ssa.Slice:
+			if s, ok := vf.writes(r.(ssa.Value), seen); ok {
+				src |= s
+				written = true
+			}
+		case *ssa.UnOp:
+			// A loaded pointer, map or slice is written through in turn: out.Owner.Name = x.
+			if r.Op == token.MUL {
+				if s, ok := vf.writes(r, seen); ok {
+					src |= s
+					written = true
+				}
+			}
+		case ssa.CallInstruction:
+			src |= fromOther
+			written = true
+		}
+	}
+	return src, written
+}
+
+// source returns where v comes from. Local memory comes from what is written to it,
+// and a call from its arguments as well as from the callee.
+func (vf *valueFlow) source(v ssa.Value) flowSource {
+	if src, ok := vf.sources[v]; ok {
+		return src
+	}
+	vf.sources[v] = 0 // a cycle adds nothing
+	var src flowSource
+	switch x := v.(type) {
+	case *ssa.Const:
+	case *ssa.Parameter:
+		src = fromOther
+		if vf.isInput(x) {
+			src = fromInput
+		}
+	case *ssa.Alloc:
+		if vf.isInput(x) {
+			src = fromInput
+		} else {
+			src, _ = vf.writes(x, make(map[ssa.Value]bool))
+		}
+	case *ssa.MakeMap, *ssa.MakeSlice:
+		src, _ = vf.writes(x, make(map[ssa.Value]bool))
+	case *ssa.Call:
+		if _, builtin := x.Call.Value.(*ssa.Builtin); !builtin {
+			src = fromOther
+		}
+		for _, arg := range x.Call.Args {
+			src |= vf.source(arg)
+		}
+		if x.Call.IsInvoke() {
+			src |= vf.source(x.Call.Value)
+		}
+	case *ssa.Phi:
+		for i, e := range x.Edges {
+			s := vf.source(e)
+			if s == 0 && vf.isControlled(x.Block().Preds[i]) {
+				s = fromInput
+			}
+			src |= s
+		}
+	case *ssa.UnOp, *ssa.BinOp, *ssa.Field, *ssa.FieldAddr, *ssa.Index, *ssa.IndexAddr,
+		*ssa.Slice, *ssa.Lookup, *ssa.Extract, *ssa.Convert, *ssa.ChangeType,
+		*ssa.MakeInterface, *ssa.ChangeInterface, *ssa.TypeAssert,
+		*ssa.SliceToArrayPointer, *ssa.MultiConvert, *ssa.Range, *ssa.Next:
+		for _, op := range v.(ssa.Instruction).Operands(nil) {
+			if *op != nil {
+				src |= vf.source(*op)
+			}
+		}
+	default:
+		src = fromOther
+	}
+	vf.sources[v] = src
+	return src
+}
+
+// isInput reports whether v is an input parameter or its local copy.
+func (vf *valueFlow) isInput(v ssa.Value) bool {
+	for _, values := range vf.inputs {
+		if slices.Contains(values, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// isControlled reports whether b only runs on one side of a branch on an input value,
+// which then chooses the values stored there. Nil checks (if in == nil { return nil },
+// if err != nil) decide whether there is an output, not what it holds: they do not count.
+func (vf *valueFlow) isControlled(b *ssa.BasicBlock) bool {
+	if res, ok := vf.controlled[b]; ok {
+		return res
+	}
+	vf.controlled[b] = false
+	res := false
+	for x := b; x != nil && !res; x = x.Idom() {
+		if len(x.Preds) != 1 || len(x.Preds[0].Instrs) == 0 {
+			continue
+		}
+		iff, ok := x.Preds[0].Instrs[len(x.Preds[0].Instrs)-1].(*ssa.If)
+		res = ok && !isNilComparison(iff.Cond) && vf.source(iff.Cond)&fromInput != 0
+	}
+	vf.controlled[b] = res
+	return res
+}
+
+// lostFields returns the top-level fields of input name whose values are read but reach
+// no output: read for logging, a comparison, an error message. A branch on a field
+// (other than a nil check) counts as reaching the output. An input handed whole to a
+// call reaching the output may have every field mapped there, so it has none.
+func (vf *valueFlow) lostFields(name string) []string {
+	// An output in a slice or a map is not followed: anything may reach it.
+	if slices.ContainsFunc(vf.outputs, func(m map[ssa.Value]bool) bool { return len(m) == 0 }) {
+		return nil
+	}
+	read := make(map[string]bool)
+	for _, root := range vf.inputs[name] {
+		st := pointedStruct(root.Type())
+		if st == nil {
+			st, _ = root.Type().Underlying().(*types.Struct)
+		}
+		if st == nil || root.Referrers() == nil {
+			continue
+		}
+		for _, ref := range *root.Referrers() {
+			var field int
+			switch r := ref.(type) {
+			case *ssa.FieldAddr:
+				field = r.Field
+			case *ssa.Field:
+				field = r.Field
+			case *ssa.Store:
+				if r.Val == root && vf.isInput(r.Addr) {
+					continue // the local copy of the parameter
+				}
+				if r.Addr == root || !vf.reachesUse(root, ref) {
+					continue
+				}
+				return nil
+			case *ssa.DebugRef:
+				continue
+			default:
+				if vf.reachesUse(root, ref) {
+					return nil
+				}
+				continue
+			}
+			name := st.Field(field).Name()
+			read[name] = read[name] || vf.reach(ref.(ssa.Value))
+		}
+	}
+	var res []string
+	for name, reached := range read {
+		if !reached {
+			res = append(res, name)
+		}
+	}
+	slices.Sort(res)
+	return res
+}
+
+// reach reports whether the value v, or what it points to, reaches an output. A field
+// read into the blank identifier (_ = in.Legacy) is acknowledged as not mapped on
+// purpose: it counts as reaching.
+func (vf *valueFlow) reach(v ssa.Value) bool {
+	if res, ok := vf.reaches[v]; ok {
+		return res
+	}
+	vf.reaches[v] = false // a cycle reaches nothing more
+	res := isDiscardedRead(v)
+	if !res && v.Referrers() != nil {
+		for _, ref := range *v.Referrers() {
+			if vf.reachesUse(v, ref) {
+				res = true
+				break
+			}
+		}
+	}
+	vf.reaches[v] = res
+	return res
+}
+
+// isDiscardedRead reports whether v is a field or pointer read whose value nothing
+// uses: what _ = in.Field compiles to.
+func isDiscardedRead(v ssa.Value) bool {
+	switch x := v.(type) {
+	case *ssa.Field:
+	case *ssa.UnOp:
+		if x.Op != token.MUL {
+			return false
+		}
+	default:
+		return false
+	}
+	for _, ref := range *v.Referrers() {
+		if _, ok := ref.(*ssa.DebugRef); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// reachesUse reports whether v reaches an output through the instruction using it:
+// stored into output memory, or into local memory that does; returned as an output;
+// deciding a branch; handed to a call along with an output, or whose result does.
+func (vf *valueFlow) reachesUse(v ssa.Value, instr ssa.Instruction) bool {
+	switch r := instr.(type) {
+	case *ssa.Store:
+		return r.Addr != v && vf.reachesMemory(memoryRoot(r.Addr))
+	case *ssa.MapUpdate:
+		return r.Map != v && vf.reachesMemory(memoryRoot(r.Map))
+	case *ssa.Return:
+		for _, pos := range vf.positions {
+			if pos < len(r.Results) && r.Results[pos] == v {
+				return true
+			}
+		}
+		return false
+	case *ssa.If:
+		return !isNilComparison(r.Cond)
+	case ssa.CallInstruction:
+		common := r.Common()
+		args := slices.Clone(common.Args)
+		if common.IsInvoke() {
+			args = append(args, common.Value)
+		}
+		for _, arg := range args {
+			if arg != v && vf.isOutputRef(arg) {
+				return true
+			}
+		}
+		if call, ok := r.(*ssa.Call); ok && vf.reach(call) {
+			return true
+		}
+		// A helper may fill a local temporary it is handed along with v.
+		for _, arg := range args {
+			if root := memoryRoot(arg); arg != v && isLocalMemory(root) && vf.reach(root) {
+				return true
+			}
+		}
+		return false
+	case *ssa.DebugRef, *ssa.Send, *ssa.Panic, *ssa.Jump, *ssa.RunDefers:
+		return false
+	}
+	if value, ok := instr.(ssa.Value); ok {
+		return vf.reach(value)
+	}
+	return false
+}
+
+// isOutputRef reports whether v points into an output, directly or as returned by a
+// call on one: b.WithID(u.ID).WithName(u.Name) chains off the builder b.
+func (vf *valueFlow) isOutputRef(v ssa.Value) bool {
+	if vf.isOutput(memoryRoot(v)) {
+		return true
+	}
+	call, ok := v.(*ssa.Call)
+	return ok && slices.ContainsFunc(call.Call.Args, vf.isOutputRef)
+}
+
+// reachesMemory reports whether what is stored in root reaches an output: root is
+// output memory, or local memory read on the way there.
+func (vf *valueFlow) reachesMemory(root ssa.Value) bool {
+	return vf.isOutput(root) || (isLocalMemory(root) && !vf.isInput(root) && vf.reach(root))
+}
+
+// memoryRoot returns the value addr points into: the allocation, parameter or loaded
+// pointer under its field, element and dereference steps.
+func memoryRoot(addr ssa.Value) ssa.Value {
+	for {
+		switch x := addr.(type) {
+		case *ssa.FieldAddr:
+			addr = x.X
+		case *ssa.IndexAddr:
+			addr = x.X
+		case *ssa.Slice:
+			addr = x.X
+		case *ssa.UnOp:
+			if x.Op != token.MUL {
+				return addr
+			}
+			addr = x.X
+		default:
+			return addr
+		}
+	}
+}
+
+// isLocalMemory reports whether v is memory the converter allocates itself.
+func isLocalMemory(v ssa.Value) bool {
+	switch v.(type) {
+	case *ssa.Alloc, *ssa.MakeMap, *ssa.MakeSlice:
+		return true
+	}
+	return false
+}
+
+// isNilComparison reports whether v compares a value with nil.
+func isNilComparison(v ssa.Value) bool {
+	bin, ok := v.(*ssa.BinOp)
+	if !ok || (bin.Op != token.EQL && bin.Op != token.NEQ) {
+		return false
+	}
+	isNil := func(v ssa.Value) bool {
+		c, isConst := v.(*ssa.Const)
+		return isConst && c.IsNil()
+	}
+	return isNil(bin.X) || isNil(bin.Y)
+}
+
+// pointedStruct returns the struct t points to, or nil.
+func pointedStruct(t types.Type) *types.Struct {
+	ptr, ok := t.Underlying().(*types.Pointer)
+	if !ok {
+		return nil
+	}
+	st, _ := ptr.Elem().Underlying().(*types.Struct)
+	return st
+}
+
+// isPlainCandidate reports whether c is a struct or a pointer to one.
+func isPlainCandidate(c candidate) bool {
+	return c.containerType == ContainerNone || c.containerType == ContainerPointer
+}
+
+// ssaParam returns the parameter of f (the receiver included) declared as name.
+func ssaParam(f *ssa.Function, name string) *ssa.Parameter {
+	if name == "" || name == "_" {
+		return nil
+	}
+	for _, p := range f.Params {
+		if p.Name() == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// ssaFunc returns the SSA function, declared or literal, whose body is body.
+func (h *helperSummaries) ssaFunc(body *ast.BlockStmt) *ssa.Function {
+	if h.ssaFuncs == nil {
+		h.ssaFuncs = make(map[*ast.BlockStmt]*ssa.Function)
+		for _, f := range h.ssa.SrcFuncs {
+			switch syntax := f.Syntax().(type) {
+			case *ast.FuncDecl:
+				h.ssaFuncs[syntax.Body] = f
+			case *ast.FuncLit:
+				h.ssaFuncs[syntax.Body] = f
+			}
+		}
+	}
+	return h.ssaFuncs[body]
+}
+
+// unflowedFields returns, as collectMissingFields would report them, the fields usage
+// covers but whose values do not flow (names, top-level): what turns missing once those
+// fields and the paths below them no longer count as used. Excluded, ignored and
+// acknowledged fields stay out.
+func unflowedFields(
+	st *types.Struct,
+	usage fieldUsage,
+	names []string,
+	pass *analysis.Pass,
+	cfg *config.Config,
+) []string {
+	if len(names) == 0 {
+		return nil
+	}
+	before := collectMissingFields(st, usage, pass, cfg)
+	trimmed := usage
+	trimmed.fields = maps.Clone(usage.fields)
+	maps.DeleteFunc(trimmed.fields, func(k string, _ struct{}) bool {
+		return slices.ContainsFunc(names, func(n string) bool { return k == n || strings.HasPrefix(k, n+".") })
+	})
+	after := collectMissingFields(st, trimmed, pass, cfg)
+	return slices.DeleteFunc(after, func(m string) bool { return slices.Contains(before, m) })
+}
@@ -0,0 +1,152 @@
+package lf
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+)
+
+// directivePrefix starts every lostfield source directive. Like //go: and //nolint:,
+// there is no space after the slashes; a spaced comment is ordinary prose.
+const directivePrefix = "//lostfield:"
+
+// Directive names understood in source comments.
+const (
+	// directiveConverter, in a function's doc comment, forces the function to be
+	// analyzed as a converter even when its type names do not match.
+	directiveConverter = "converter"
+	// directiveIgnore, in a function's doc comment, skips that function.
+	directiveIgnore = "ignore"
+	// directiveFileIgnore, anywhere in a file, skips every function in the file.
+	directiveFileIgnore = "file-ignore"
+	// directiveSkip, inside (or in the doc comment of) a converter, marks the listed
+	// field paths as deliberately not mapped: "//lostfield:skip Email, User.Role.Name".
+	directiveSkip = "skip"
+)
+
+// knownDirectives lists every directive name, so a typo is reported instead of
+// silently doing nothing.
+var knownDirectives = map[string]bool{
+	directiveConverter:  true,
+	directiveIgnore:     true,
+	directiveFileIgnore: true,
+	directiveSkip:       true,
+}
+
+// directive is one parsed //lostfield: comment.
+//
+// The syntax is "//lostfield:<name> [args] [-- reason]". Everything after "--" is a
+// free-form reason kept for the reader (and shown in verbose mode); directives that
+// take no arguments treat any trailing text as the reason too, so both
+// "//lostfield:ignore -- legacy API" and "//lostfield:ignore legacy API" work.
+type directive struct {
+	name   string
+	args   string
+	reason string
+	pos    token.Pos
+}
+
+// parseDirective parses a single comment, returning ok==false when it is not a
+// //lostfield: directive.
+func parseDirective(c *ast.Comment) (directive, bool) {
+	rest, ok := strings.CutPrefix(c.Text, directivePrefix)
+	if !ok {
+		return directive{}, false
+	}
+
+	name, tail, _ := strings.Cut(rest, " ")
+	d := directive{name: strings.TrimSpace(name), pos: c.Slash}
+
+	args, reason, hasReason := strings.Cut(tail, "--")
+	d.args = strings.TrimSpace(args)
+	if hasReason {
+		d.reason = strings.TrimSpace(reason)
+	}
+	return d, true
+}
+
+// directivesIn returns the lostfield directives found in a comment group.
+func directivesIn(cg *ast.CommentGroup) []directive {
+	if cg == nil {
+		return nil
+	}
+	var res []directive
+	for _, c := range cg.List {
+		if d, ok := parseDirective(c); ok {
+			res = append(res, d)
+		}
+	}
+	return res
+}
+
+// findDirective returns the first directive named name in a comment group.
+func findDirective(cg *ast.CommentGroup, name string) (directive, bool) {
+	for _, d := range directivesIn(cg) {
+		if d.name == name {
+			return d, true
+		}
+	}
+	return directive{}, false
+}
+
+// reasonText returns the directive's reason, falling back to its arguments for the
+// argument-less directives (converter, ignore, file-ignore). skip takes field paths as
+// arguments, so its reason must follow "--".
+func (d directive) reasonText() string {
+	if d.reason != "" {
+		return d.reason
+	}
+	return d.args
+}
+
+// funcDirectives holds the directives attached to one function's doc comment.
+type funcDirectives struct {
+	converter *directive
+	ignore    *directive
+}
+
+// parseFuncDirectives reads the directives of a function declaration's doc comment.
+func parseFuncDirectives(fn *ast.FuncDecl) funcDirectives {
+	var fd funcDirectives
+	for _, d := range directivesIn(fn.Doc) {
+		switch d.name {
+		case directiveConverter:
+			fd.converter = &d
+		case directiveIgnore:
+			fd.ignore = &d
+		}
+	}
+	return fd
+}
+
+// isForcedConverter reports whether fn carries a //lostfield:converter directive.
+func isForcedConverter(fn *ast.FuncDecl) bool {
+	_, ok := findDirective(fn.Doc, directiveConverter)
+	return ok
+}
+
+// fileIgnoreDirective returns the //lostfield:file-ignore directive of a file, if any.
+// It may sit anywhere in the file, though the top (above or below the package clause)
+// is where readers will look for it.
+func fileIgnoreDirective(file *ast.File) (directive, bool) {
+	for _, cg := range file.Comments {
+		if d, ok := findDirective(cg, directiveFileIgnore); ok {
+			return d, true
+		}
+	}
+	return directive{}, false
+}
+
+// unknownDirectives returns every //lostfield: directive in the file whose name is
+// not recognized.
+func unknownDirectives(file *ast.File) []directive {
+	var res []directive
+	for _, cg := range file.Comments {
+		for _, d := range directivesIn(cg) {
+			if !knownDirectives[d.name] {
+				res = append(res, d)
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,41 @@
+package lf_test
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/amberpixels/lostfield/internal/lf"
+)
+
+func TestParseDirective(t *testing.T) {
+	tests := []struct {
+		text       string
+		wantOK     bool
+		wantName   string
+		wantArgs   string
+		wantReason string
+	}{
+		{text: "//lostfield:ignore", wantOK: true, wantName: "ignore"},
+		{text: "//lostfield:ignore -- legacy API", wantOK: true, wantName: "ignore", wantReason: "legacy API"},
+		{text: "//lostfield:converter", wantOK: true, wantName: "converter"},
+		{text: "//lostfield:file-ignore reviewed by hand", wantOK: true, wantName: "file-ignore", wantArgs: "reviewed by hand"},
+		{text: "// lostfield:ignore", wantOK: false},
+		{text: "//nolint:lostfield", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			d, ok := lf.ParseDirective(&ast.Comment{Text: tt.text})
+			if ok != tt.wantOK {
+				t.Fatalf("ParseDirective(%q) ok = %v, want %v", tt.text, ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if d.Name() != tt.wantName || d.Args() != tt.wantArgs || d.Reason() != tt.wantReason {
+				t.Errorf("ParseDirective(%q) = {%q %q %q}, want {%q %q %q}", tt.text,
+					d.Name(), d.Args(), d.Reason(), tt.wantName, tt.wantArgs, tt.wantReason)
+			}
+		})
+	}
+}
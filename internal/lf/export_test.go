@@ -3,7 +3,15 @@ package lf
 // Internals exposed for black-box tests.
 var (
 	TypeNameSimilarity   = typeNameSimilarity
+	TypeNamesMatch       = typeNamesMatch
+	NameTokens           = nameTokens
 	IsFieldTagIgnored    = isFieldTagIgnored
 	IsFieldExcluded      = isFieldExcluded
 	CompileFieldPatterns = compileFieldPatterns
+	ParseDirective       = parseDirective
 )
+
+// Name, Args and Reason expose a parsed directive's parts.
+func (d directive) Name() string   { return d.name }
+func (d directive) Args() string   { return d.args }
+func (d directive) Reason() string { return d.reason }
@@ -0,0 +1,208 @@
+package lf
+
+import (
+	"go/ast"
+	"go/types"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// FieldUsageFact is the object fact exported for an exported helper function: the fields
+// and methods it uses on each of its struct parameters (and receiver), directly or through
+// its own helpers. It carries helper summaries across packages, so a converter calling
+// mapping.FillAddress(&out, u) is credited with what FillAddress maps.
+type FieldUsageFact struct {
+	Recv   ParamUsage
+	Params []ParamUsage
+}
+
+// ParamUsage is the usage of one parameter recorded in a FieldUsageFact. Fields include,
+// for a pointer parameter, the keys of a literal stored through it; Setters holds the
+// fields written through setter methods, which count when the parameter is an output.
+type ParamUsage struct {
+	Name    string
+	Fields  []string
+	Methods []string
+	Setters []string
+}
+
+// AFact marks FieldUsageFact as an analysis.Fact.
+func (*FieldUsageFact) AFact() {}
+
+// String renders the fact as "fieldUsage(dst: City, Street; a: Street)", listing the
+// fields (setter writes included) of each parameter that uses some.
+func (f *FieldUsageFact) String() string {
+	var parts []string
+	for _, p := range append([]ParamUsage{f.Recv}, f.Params...) {
+		if fields := slices.Compact(slices.Sorted(slices.Values(append(slices.Clone(p.Fields), p.Setters...)))); len(fields) > 0 {
+			parts = append(parts, p.Name+": "+strings.Join(fields, ", "))
+		}
+	}
+	return "fieldUsage(" + strings.Join(parts, "; ") + ")"
+}
+
+// DeprecatedFieldFact is the object fact exported for a struct field whose doc comment
+// contains "Deprecated:", so that packages using the type skip it too when
+// IncludeDeprecated is off.
+type DeprecatedFieldFact struct{}
+
+// AFact marks DeprecatedFieldFact as an analysis.Fact.
+func (*DeprecatedFieldFact) AFact() {}
+
+// String renders the fact as "deprecated".
+func (*DeprecatedFieldFact) String() string { return "deprecated" }
+
+// exportDeprecatedFieldFacts exports a DeprecatedFieldFact for every deprecated struct
+// field declared in the package. Every file counts, generated and excluded ones too:
+// protoc marks deprecated fields with "// Deprecated: Marked as deprecated in x.proto."
+// in .pb.go files that are usually left out of the analysis.
+func exportDeprecatedFieldFacts(pass *analysis.Pass) {
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			structType, ok := n.(*ast.StructType)
+			if !ok || structType.Fields == nil {
+				return true
+			}
+			for _, f := range structType.Fields.List {
+				if f.Doc == nil || !strings.Contains(f.Doc.Text(), "Deprecated:") {
+					continue
+				}
+				for _, name := range f.Names {
+					if field, isVar := pass.TypesInfo.Defs[name].(*types.Var); isVar {
+						pass.ExportObjectFact(field, &DeprecatedFieldFact{})
+					}
+				}
+			}
+			return true
+		})
+	}
+}
+
+// exportFieldUsageFacts exports a FieldUsageFact for every exported function of the
+// package that is not a converter and uses a field or method of a struct parameter.
+// Converters are left out: they are validated on their own, and a caller forwarding to
+// one is a delegating converter.
+func exportFieldUsageFacts(pass *analysis.Pass, h *helperSummaries) {
+	for obj, decl := range h.decls {
+		sig, ok := obj.Type().(*types.Signature)
+		if !ok || !obj.Exported() || !hasStructParam(sig) || IsPossibleConverter(decl, pass, h.cfg) {
+			continue
+		}
+		s, _ := h.summary(obj, 0)
+		if s == nil {
+			continue
+		}
+		fact := &FieldUsageFact{}
+		used := false
+		if recv := sig.Recv(); recv != nil && isStructParam(recv.Type()) {
+			fact.Recv = newParamUsage(recv.Name(), s.recv)
+			used = fact.Recv.used()
+		}
+		for i, u := range s.params {
+			var p ParamUsage
+			if i < sig.Params().Len() && isStructParam(sig.Params().At(i).Type()) {
+				p = newParamUsage(sig.Params().At(i).Name(), u)
+				used = used || p.used()
+			}
+			fact.Params = append(fact.Params, p)
+		}
+		if used {
+			pass.ExportObjectFact(obj, fact)
+		}
+	}
+}
+
+// importedSummary returns the summary of a function from another package, from its
+// FieldUsageFact, or nil when it has none.
+func (h *helperSummaries) importedSummary(fn *types.Func) *funcSummary {
+	if fn.Pkg() == nil || fn.Pkg() == h.pass.Pkg || h.pass.ImportObjectFact == nil {
+		return nil
+	}
+	var fact FieldUsageFact
+	if !h.pass.ImportObjectFact(fn, &fact) {
+		return nil
+	}
+	s := &funcSummary{recv: fact.Recv.varUsage()}
+	for _, p := range fact.Params {
+		s.params = append(s.params, p.varUsage())
+	}
+	return s
+}
+
+// newParamUsage records u under the parameter name, in sorted order.
+func newParamUsage(name string, u varUsage) ParamUsage {
+	p := ParamUsage{Name: name}
+	for k := range u.fields {
+		p.Fields = append(p.Fields, k)
+	}
+	for k := range u.methods {
+		p.Methods = append(p.Methods, k)
+	}
+	for k := range u.setters {
+		p.Setters = append(p.Setters, k)
+	}
+	slices.Sort(p.Fields)
+	slices.Sort(p.Methods)
+	slices.Sort(p.Setters)
+	return p
+}
+
+// used reports whether the parameter uses anything.
+func (p ParamUsage) used() bool {
+	return len(p.Fields)+len(p.Methods)+len(p.Setters) > 0
+}
+
+// varUsage turns a recorded parameter usage back into lookups.
+func (p ParamUsage) varUsage() varUsage {
+	u := varUsage{fields: make(UsageLookup), methods: make(UsageLookup), setters: make(UsageLookup)}
+	for _, k := range p.Fields {
+		u.fields.Add(k)
+	}
+	for _, k := range p.Methods {
+		u.methods.Add(k)
+	}
+	for _, k := range p.Setters {
+		u.setters.Add(k)
+	}
+	return u
+}
+
+// hasStructParam reports whether the receiver or a parameter of sig is a struct or a
+// pointer to one: only those have fields to record.
+func hasStructParam(sig *types.Signature) bool {
+	if recv := sig.Recv(); recv != nil && isStructParam(recv.Type()) {
+		return true
+	}
+	for p := range sig.Params().Variables() {
+		if isStructParam(p.Type()) {
+			return true
+		}
+	}
+	return false
+}
+
+// isStructParam reports whether t is a struct or a pointer to one.
+func isStructParam(t types.Type) bool {
+	if ptr, ok := types.Unalias(t).(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	_, ok := t.Underlying().(*types.Struct)
+	return ok
+}
+
+// IncompleteConverterFact is the object fact exported for an exported converter that
+// leaves fields out, so that a converter of another package filling a field with it
+// (Role: mapping.ToRoleDTO(in.Role)) is reported as relying on an incomplete converter.
+type IncompleteConverterFact struct {
+	Missing []string
+}
+
+// AFact marks IncompleteConverterFact as an analysis.Fact.
+func (*IncompleteConverterFact) AFact() {}
+
+// String renders the fact as "incompleteConverter(r.Name, out.Title)".
+func (f *IncompleteConverterFact) String() string {
+	return "incompleteConverter(" + strings.Join(f.Missing, ", ") + ")"
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/expectto/be/be_math"
 	. "github.com/onsi/gomega"
 
+	"github.com/amberpixels/lostfield/internal/config"
 	"github.com/amberpixels/lostfield/internal/lf"
 )
 
@@ -32,6 +33,55 @@ func TestTypeNameSimilarity(t *testing.T) {
 	g.Expect(lf.TypeNameSimilarity("A", "a")).To(be.Eq(1.0))
 }
 
+func TestNameTokens(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(lf.NameTokens("UserDTO")).To(Equal([]string{"user", "dto"}))
+	g.Expect(lf.NameTokens("PBUser")).To(Equal([]string{"pb", "user"}))
+	g.Expect(lf.NameTokens("HTTPRequestRow")).To(Equal([]string{"http", "request", "row"}))
+	g.Expect(lf.NameTokens("V2User")).To(Equal([]string{"v2", "user"}))
+	g.Expect(lf.NameTokens("user_record")).To(Equal([]string{"user", "record"}))
+}
+
+func TestTypeNamesMatch(t *testing.T) {
+	containment := config.DefaultConfig()
+	tokens := config.DefaultConfig()
+	tokens.NameMatching = config.NameMatchingTokens
+
+	t.Run("containment stays the default", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(lf.TypeNamesMatch("User", "UserDTO", &containment)).To(be.True())
+		g.Expect(lf.TypeNamesMatch("Order", "BorderDTO", &containment)).To(be.True())
+		g.Expect(lf.TypeNamesMatch("UserRecord", "UserDTO", &containment)).To(be.False())
+	})
+
+	t.Run("tokens strip affixes and match whole tokens", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(lf.TypeNamesMatch("UserRecord", "UserDTO", &tokens)).To(be.True())
+		g.Expect(lf.TypeNamesMatch("PBUser", "UserEntity", &tokens)).To(be.True())
+		g.Expect(lf.TypeNamesMatch("User", "UserProfileResponse", &tokens)).To(be.True())
+		g.Expect(lf.TypeNamesMatch("Order", "BorderDTO", &tokens)).To(be.False())
+		// Affixes never strip a name to nothing, so DTO -> Model are still unrelated.
+		g.Expect(lf.TypeNamesMatch("DTO", "Model", &tokens)).To(be.False())
+	})
+
+	t.Run("min-similarity applies to the normalized names", func(t *testing.T) {
+		g := NewWithT(t)
+		cfg := tokens
+		cfg.MinTypeNameSimilarity = 0.9
+		g.Expect(lf.TypeNamesMatch("UserRecord", "UserDTO", &cfg)).To(be.True())
+		g.Expect(lf.TypeNamesMatch("User", "UserProfile", &cfg)).To(be.False())
+	})
+
+	t.Run("affixes are configurable", func(t *testing.T) {
+		g := NewWithT(t)
+		cfg := tokens
+		cfg.NameAffixes = []string{"NewParams"}
+		g.Expect(lf.TypeNamesMatch("Message", "MessageNewParams", &cfg)).To(be.True())
+		g.Expect(lf.TypeNamesMatch("UserRecord", "UserDTO", &cfg)).To(be.False())
+	})
+}
+
 func TestIsFieldTagIgnored(t *testing.T) {
 	g := NewWithT(t)
 
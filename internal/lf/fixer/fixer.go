@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"go/token"
 	"go/types"
+	"slices"
 	"strings"
 
 	"golang.org/x/tools/go/analysis"
@@ -33,6 +34,10 @@ type FixContext struct {
 	OutputStyle   OutputStyle   // composite-lit vs dot-assignment
 	CompLitRbrace token.Pos     // for inserting into composite literal (smart fix)
 	IsSliceInline bool          // true when inFieldVar is a loop variable
+	ExtraInVars   []string      // further inputs of a multi-input converter (stubbed only)
+	// OutSetters maps output fields to their setter ("Name" -> "SetName"), for types
+	// filled through methods: smart fixes call the setter instead of assigning the field.
+	OutSetters map[string]string
 }
 
 // outInsertPos returns the best position to insert output stubs.
@@ -63,24 +68,27 @@ func GenerateFixes(
 		return nil
 	}
 
+	// Fields of further inputs get plain stubs; only the first input is mapped.
+	primaryIn, extraInStubs := splitExtraInputs(validation.MissingInputFields, fixCtx.ExtraInVars)
+
 	// Separate missing fields into top-level names (strip var prefix).
-	missingIn := topLevelFields(validation.MissingInputFields, fixCtx.InFieldVar)
+	missingIn := topLevelFields(primaryIn, fixCtx.InFieldVar)
 	missingOut := topLevelFields(validation.MissingOutputFields, fixCtx.OutVar)
 
-	if len(missingIn) == 0 && len(missingOut) == 0 {
+	if len(missingIn) == 0 && len(missingOut) == 0 && len(extraInStubs) == 0 {
 		return nil
 	}
 
 	var fixes []analysis.SuggestedFix
 
 	if mode == "smart" {
-		smartFix := generateSmartFix(fixCtx, missingIn, missingOut)
+		smartFix := generateSmartFix(fixCtx, missingIn, missingOut, extraInStubs)
 		if smartFix != nil {
 			fixes = append(fixes, *smartFix)
 		}
 	}
 
-	safeFix := generateSafeFix(fixCtx, missingIn, missingOut)
+	safeFix := generateSafeFix(fixCtx, missingIn, missingOut, extraInStubs)
 	if safeFix != nil {
 		fixes = append(fixes, *safeFix)
 	}
@@ -113,36 +121,57 @@ func topLevelFields(qualifiedFields []string, varName string) []string {
 	return result
 }
 
+// splitExtraInputs separates the missing fields of the further inputs from those of
+// the first one, returning the latter and `_ = var.Field` stubs for the former.
+func splitExtraInputs(qualifiedFields, extraVars []string) ([]string, []string) {
+	if len(extraVars) == 0 {
+		return qualifiedFields, nil
+	}
+	var primary, stubs []string
+	seen := make(map[string]bool)
+	for _, qf := range qualifiedFields {
+		head, rest, _ := strings.Cut(qf, ".")
+		if !slices.Contains(extraVars, head) {
+			primary = append(primary, qf)
+			continue
+		}
+		// Stub only the top-level field, like topLevelFields does for the first input.
+		field, _, _ := strings.Cut(rest, ".")
+		stub := fmt.Sprintf("\t_ = %s.%s", head, field)
+		if !seen[stub] {
+			seen[stub] = true
+			stubs = append(stubs, stub)
+		}
+	}
+	return primary, stubs
+}
+
 // generateSafeFix generates a safe fix that suppresses warnings without changing behavior.
 // Uses `_ = var.Field` pattern for both input and output missing fields.
 func generateSafeFix(
 	fixCtx *FixContext,
-	missingIn, missingOut []string,
+	missingIn, missingOut, extraInStubs []string,
 ) *analysis.SuggestedFix {
 	var edits []analysis.TextEdit
 
-	// For slice inline mapping, emit a TODO comment instead of stubs for input fields
-	if fixCtx.IsSliceInline && len(missingIn) > 0 {
-		var lines []string
+	var inLines []string
+	if fixCtx.IsSliceInline {
+		// For slice inline mapping, emit a TODO comment instead of stubs for input fields
 		for _, field := range missingIn {
-			lines = append(lines, fmt.Sprintf(
+			inLines = append(inLines, fmt.Sprintf(
 				"\t// TODO(lostfield): handle %s.%s in loop body",
 				fixCtx.InFieldVar, field,
 			))
 		}
-		text := "\n" + strings.Join(lines, "\n") + "\n"
-		edits = append(edits, analysis.TextEdit{
-			Pos:     fixCtx.FnBodyLbrace + 1,
-			End:     fixCtx.FnBodyLbrace + 1,
-			NewText: []byte(text),
-		})
-	} else if len(missingIn) > 0 {
+	} else {
 		// Insert `_ = inVar.Field` after opening brace
-		var lines []string
 		for _, field := range missingIn {
-			lines = append(lines, fmt.Sprintf("\t_ = %s.%s", fixCtx.InFieldVar, field))
+			inLines = append(inLines, fmt.Sprintf("\t_ = %s.%s", fixCtx.InFieldVar, field))
 		}
-		text := "\n" + strings.Join(lines, "\n") + "\n"
+	}
+	inLines = append(inLines, extraInStubs...)
+	if len(inLines) > 0 {
+		text := "\n" + strings.Join(inLines, "\n") + "\n"
 		edits = append(edits, analysis.TextEdit{
 			Pos:     fixCtx.FnBodyLbrace + 1,
 			End:     fixCtx.FnBodyLbrace + 1,
@@ -194,7 +223,7 @@ func generateSafeFix(
 // generateSmartFix generates smart fixes that infer correct field mappings.
 func generateSmartFix(
 	fixCtx *FixContext,
-	missingIn, missingOut []string,
+	missingIn, missingOut, extraInStubs []string,
 ) *analysis.SuggestedFix {
 	// Build sets for quick lookup
 	missingInSet := make(map[string]bool)
@@ -209,6 +238,7 @@ func generateSmartFix(
 	// Fields in both sides can get smart fixes
 	var smartAssignments []string
 	var smartCompLitEntries []string
+	var setterCalls []string
 	var safeInStubs []string
 	var safeOutStubs []string
 	var todoComments []string
@@ -223,6 +253,12 @@ func generateSmartFix(
 			inType := inFieldTypes[field]
 			outType := outFieldTypes[field]
 
+			if setter := fixCtx.OutSetters[field]; setter != "" && fixCtx.OutVar != "" {
+				// Setter calls are statements, whatever the output style.
+				setterCalls = append(setterCalls, inferSetterCall(fixCtx, field, setter, inType, outType))
+				continue
+			}
+
 			assignment := inferAssignment(fixCtx, field, inType, outType)
 			if assignment != "" {
 				if fixCtx.OutputStyle == OutputStyleCompositeLit {
@@ -262,6 +298,7 @@ func generateSmartFix(
 	var afterLbrace []string
 	afterLbrace = append(afterLbrace, todoComments...)
 	afterLbrace = append(afterLbrace, safeInStubs...)
+	afterLbrace = append(afterLbrace, extraInStubs...)
 	if len(afterLbrace) > 0 {
 		text := "\n" + strings.Join(afterLbrace, "\n") + "\n"
 		edits = append(edits, analysis.TextEdit{
@@ -286,6 +323,7 @@ func generateSmartFix(
 	if fixCtx.OutputStyle == OutputStyleDotAssignment {
 		beforeReturn = append(beforeReturn, smartAssignments...)
 	}
+	beforeReturn = append(beforeReturn, setterCalls...)
 	beforeReturn = append(beforeReturn, safeOutStubs...)
 	if len(beforeReturn) > 0 {
 		insertPos := fixCtx.outInsertPos()
@@ -330,19 +368,11 @@ func inferAssignment(fixCtx *FixContext, field string, inType, outType types.Typ
 	}
 
 	// 2. Getter exists
-	if fixCtx.InNamedType != nil {
-		getterName := "Get" + field
-		for method := range fixCtx.InNamedType.Methods() {
-			if method.Name() == getterName {
-				sig, ok := method.Type().(*types.Signature)
-				if ok && sig.Params().Len() == 0 && sig.Results().Len() >= 1 {
-					if fixCtx.OutputStyle == OutputStyleCompositeLit {
-						return fmt.Sprintf("\t\t%s: %s.%s(),", field, inVar, getterName)
-					}
-					return fmt.Sprintf("\t%s.%s = %s.%s()", outVar, field, inVar, getterName)
-				}
-			}
+	if getterName, ok := inputGetter(fixCtx, field); ok {
+		if fixCtx.OutputStyle == OutputStyleCompositeLit {
+			return fmt.Sprintf("\t\t%s: %s.%s(),", field, inVar, getterName)
 		}
+		return fmt.Sprintf("\t%s.%s = %s.%s()", outVar, field, inVar, getterName)
 	}
 
 	// 3. Types convertible
@@ -361,6 +391,46 @@ func inferAssignment(fixCtx *FixContext, field string, inType, outType types.Typ
 	return fmt.Sprintf("\t// TODO(lostfield): convert %s.%s\n\t_ = %s.%s", inVar, field, inVar, field)
 }
 
+// inferSetterCall generates the setter call filling an output field, reading the input
+// the way inferAssignment does.
+func inferSetterCall(fixCtx *FixContext, field, setter string, inType, outType types.Type) string {
+	inVar := fixCtx.InFieldVar
+	outVar := fixCtx.OutVar
+
+	switch {
+	case inType == nil || outType == nil:
+		return fmt.Sprintf("\t%s.%s(%s.%s) // TODO(lostfield): verify type", outVar, setter, inVar, field)
+	case types.Identical(inType, outType):
+		return fmt.Sprintf("\t%s.%s(%s.%s)", outVar, setter, inVar, field)
+	}
+	if getterName, ok := inputGetter(fixCtx, field); ok {
+		return fmt.Sprintf("\t%s.%s(%s.%s())", outVar, setter, inVar, getterName)
+	}
+	if types.ConvertibleTo(inType, outType) {
+		outTypeName := types.TypeString(outType, nil)
+		return fmt.Sprintf("\t%s.%s(%s(%s.%s))", outVar, setter, outTypeName, inVar, field)
+	}
+	return fmt.Sprintf("\t// TODO(lostfield): convert %s.%s\n\t_ = %s.%s", inVar, field, inVar, field)
+}
+
+// inputGetter returns the name of the input type's Get<field> method, if it has one
+// taking no arguments and returning a value.
+func inputGetter(fixCtx *FixContext, field string) (string, bool) {
+	if fixCtx.InNamedType == nil {
+		return "", false
+	}
+	getterName := "Get" + field
+	for method := range fixCtx.InNamedType.Methods() {
+		if method.Name() == getterName {
+			sig, ok := method.Type().(*types.Signature)
+			if ok && sig.Params().Len() == 0 && sig.Results().Len() >= 1 {
+				return getterName, true
+			}
+		}
+	}
+	return "", false
+}
+
 // buildFieldTypeMap builds a map from field name to field type for a struct.
 func buildFieldTypeMap(st *types.Struct) map[string]types.Type {
 	m := make(map[string]types.Type)
@@ -105,3 +105,63 @@ func TestGenerateFixes_SliceInline(t *testing.T) {
 		t.Errorf("expected TODO comment for slice inline, got: %s", text)
 	}
 }
+
+func TestGenerateFixes_ExtraInputs(t *testing.T) {
+	ctx := &fixer.FixContext{
+		InVar:        "u",
+		OutVar:       "result",
+		InFieldVar:   "u",
+		FnBodyLbrace: 100,
+		FnBodyRbrace: 200,
+		OutputStyle:  fixer.OutputStyleDotAssignment,
+		ExtraInVars:  []string{"s"},
+	}
+	validation := &fixer.ValidationResult{
+		MissingInputFields: []string{"u.Name", "s.Theme", "s.Prefs.Lang"},
+	}
+
+	fixes := fixer.GenerateFixes(ctx, validation, "safe")
+	if len(fixes) != 1 {
+		t.Fatalf("expected 1 fix, got %d", len(fixes))
+	}
+	// Each input is stubbed through its own variable
+	text := string(fixes[0].TextEdits[0].NewText)
+	for _, want := range []string{"_ = u.Name", "_ = s.Theme", "_ = s.Prefs"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %q in fix, got: %s", want, text)
+		}
+	}
+	if strings.Contains(text, "u.s") {
+		t.Errorf("extra input fields must not be stubbed through the first input, got: %s", text)
+	}
+}
+
+func TestGenerateFixes_Setters(t *testing.T) {
+	ctx := &fixer.FixContext{
+		InVar:         "u",
+		OutVar:        "out",
+		InFieldVar:    "u",
+		FnBodyLbrace:  100,
+		FnBodyRbrace:  200,
+		OutputStyle:   fixer.OutputStyleCompositeLit,
+		CompLitRbrace: 150,
+		OutSetters:    map[string]string{"Email": "SetEmail"},
+	}
+	validation := &fixer.ValidationResult{
+		MissingInputFields:  []string{"u.Email"},
+		MissingOutputFields: []string{"out.Email"},
+	}
+
+	fixes := fixer.GenerateFixes(ctx, validation, "smart")
+	if len(fixes) != 2 {
+		t.Fatalf("expected 2 fixes, got %d", len(fixes))
+	}
+	// A setter call is a statement: it goes before the return, not into the literal.
+	edits := fixes[0].TextEdits
+	if len(edits) != 1 || edits[0].Pos != 200 {
+		t.Fatalf("expected one edit before the closing brace, got: %+v", edits)
+	}
+	if text := string(edits[0].NewText); !strings.Contains(text, "out.SetEmail(u.Email)") {
+		t.Errorf("expected a setter call, got: %s", text)
+	}
+}
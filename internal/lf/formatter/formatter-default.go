@@ -12,9 +12,14 @@ type defaultFormatter struct{}
 
 // Format produces a standard go vet format diagnostic message.
 // Creates a concise, single-line message from raw validation data.
-// Example output: "ToPM: incomplete converter with missing fields: Categories, Sections, URLValidated, Email".
+// Example output: "ToPM: incomplete converter with missing fields: Categories, Sections, URLValidated, Email",
+// or "ToUserDTO: incomplete converter (Role via toRoleDTO: incomplete converter)", or in
+// dataflow mode "ToDTO: incomplete converter with fields set only from constants: Email".
 func (d *defaultFormatter) Format(ctx *FormatContext) string {
 	fnName := ctx.Fn.Name.Name
+	if ctx.Return != nil {
+		fnName += fmt.Sprintf(" (return at line %d)", ctx.Pass.Fset.Position(ctx.Return.Pos()).Line)
+	}
 	validation := ctx.Validation
 
 	// Collect all missing fields (both input and output)
@@ -30,11 +35,36 @@ func (d *defaultFormatter) Format(ctx *FormatContext) string {
 		prefix = fmt.Sprintf("[%d/%d] ", ctx.Index, ctx.Total)
 	}
 
-	// Build a standard go vet-style message
-	if len(missingFields) == 0 {
-		return fmt.Sprintf("%s%s: incomplete converter", prefix, fnName)
+	// A converter copying by reflection is told apart: the fields are the ones the copy
+	// could not pair by name.
+	kind := "incomplete converter"
+	if validation.CopyCall != "" {
+		kind = fmt.Sprintf("unchecked reflective copy (%s)", validation.CopyCall)
+	}
+	// Nested converter calls that cannot vouch for their field follow the kind.
+	if len(validation.NestedCalls) > 0 {
+		calls := make([]string, len(validation.NestedCalls))
+		for i, nc := range validation.NestedCalls {
+			calls[i] = nc.String()
+		}
+		kind += " (" + strings.Join(calls, ", ") + ")"
+	}
+
+	// Build a standard go vet-style message; dataflow findings follow the missing fields.
+	var details []string
+	if len(missingFields) > 0 {
+		details = append(details, "missing fields: "+strings.Join(missingFields, ", "))
+	}
+	if len(validation.ConstantOutputFields) > 0 {
+		details = append(details, "fields set only from constants: "+
+			strings.Join(validation.ConstantOutputFields, ", "))
+	}
+	if len(validation.LostInputFields) > 0 {
+		details = append(details, "fields reaching no output: "+strings.Join(validation.LostInputFields, ", "))
+	}
+	if len(details) == 0 {
+		return fmt.Sprintf("%s%s: %s", prefix, fnName, kind)
 	}
 
-	return fmt.Sprintf("%s%s: incomplete converter with missing fields: %s",
-		prefix, fnName, strings.Join(missingFields, ", "))
+	return fmt.Sprintf("%s%s: %s with %s", prefix, fnName, kind, strings.Join(details, "; "))
 }
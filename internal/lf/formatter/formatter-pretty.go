@@ -44,7 +44,11 @@ func (c *prettyFormatter) Format(ctx *FormatContext) string {
 	message := c.formatValidationMessage(ctx.Validation, ctx.Verbose)
 
 	var buf bytes.Buffer
-	c.prettyPrint(&buf, ctx.Filename, ctx.Fn, ctx.Pass, message, ctx.Validation.ConverterType, ctx.Index, ctx.Total)
+	converterType := ctx.Validation.ConverterType
+	if ctx.Validation.CopyCall != "" {
+		converterType += " (" + ctx.Validation.CopyCall + ")"
+	}
+	c.prettyPrint(&buf, ctx.Filename, ctx.Fn, ctx.Return, ctx.Pass, message, converterType, ctx.Index, ctx.Total)
 	return buf.String()
 }
 
@@ -78,7 +82,32 @@ const maxFieldsPerSide = 3
 // formatValidationMessage creates a detailed field mapping message from raw validation data.
 // This is the core message that the pretty printer will display.
 // When verbose is false, fields are truncated to maxFieldsPerSide per side with a hint.
+// Dataflow findings and nested converter calls get notes of their own, after the missing
+// fields if there are any.
 func (c *prettyFormatter) formatValidationMessage(validation *ConverterValidationResult, verbose bool) string {
+	hasFlow := len(validation.ConstantOutputFields)+len(validation.LostInputFields) > 0
+	if len(validation.NestedCalls) == 0 && !hasFlow {
+		return c.formatMissingFields(validation, verbose)
+	}
+	var notes []string
+	if len(validation.MissingInputFields)+len(validation.MissingOutputFields) > 0 {
+		notes = append(notes, c.formatMissingFields(validation, verbose))
+	}
+	if len(validation.ConstantOutputFields) > 0 {
+		notes = append(notes, "= note: set only from constants: "+strings.Join(validation.ConstantOutputFields, ", "))
+	}
+	if len(validation.LostInputFields) > 0 {
+		notes = append(notes, "= note: reaching no output: "+strings.Join(validation.LostInputFields, ", "))
+	}
+	for _, nc := range validation.NestedCalls {
+		notes = append(notes, "= note: "+nc.String())
+	}
+	return strings.Join(notes, "\n")
+}
+
+// formatMissingFields lists the missing fields of each side, as field → ?? for the input
+// and ?? → field for the output.
+func (c *prettyFormatter) formatMissingFields(validation *ConverterValidationResult, verbose bool) string {
 	var buf strings.Builder
 
 	totalMissing := len(validation.MissingInputFields) + len(validation.MissingOutputFields)
@@ -164,12 +193,17 @@ func (c *prettyFormatter) prettyPrint(
 	w *bytes.Buffer,
 	filename string,
 	fn *ast.FuncDecl,
+	ret *ast.ReturnStmt,
 	pass *analysis.Pass,
 	message string,
 	converterType string,
 	index, total int,
 ) {
+	// A path-sensitive finding points at the offending return instead of the name.
 	pos := pass.Fset.Position(fn.Name.Pos())
+	if ret != nil {
+		pos = pass.Fset.Position(ret.Pos())
+	}
 
 	sourceLine := c.sourceLine(filename, pos.Line)
 	if sourceLine == "" {
@@ -199,6 +233,14 @@ func (c *prettyFormatter) prettyPrint(
 	// Print header.
 	fnName := fn.Name.Name
 	fnNameLen := utf8.RuneCountInString(fnName)
+	// A function literal is reported under a synthesized name ("converters[KindUser]")
+	// that is not in the source: underline its func keyword instead.
+	if !strings.HasPrefix(sourceLine[byteCaret:], fnName) && strings.HasPrefix(sourceLine[byteCaret:], "func") {
+		fnNameLen = len("func")
+	}
+	if ret != nil {
+		fnNameLen = len("return")
+	}
 
 	// Print with extra spacing (4 spaces min) before the function code
 	const minSpacing = 4
@@ -18,6 +18,25 @@ type ConverterValidationResult struct {
 	ConverterType       string
 	MissingInputFields  []string
 	MissingOutputFields []string
+	CopyCall            string // the reflective copy call of an unchecked copy ("copier.Copy"), or ""
+	NestedCalls         []NestedCall
+	// LostInputFields and ConstantOutputFields are the dataflow findings: input fields
+	// whose values reach no output, output fields set only from constants.
+	LostInputFields      []string
+	ConstantOutputFields []string
+}
+
+// NestedCall is an output field filled by a nested converter call whose callee is not a
+// complete converter.
+type NestedCall struct {
+	Field   string // the output field path ("Role")
+	Callee  string // the callee as reported ("toRoleDTO", "mapping.ToRoleDTO")
+	Problem string // "incomplete converter" or "not a converter"
+}
+
+// String renders the call as "Role via toRoleDTO: incomplete converter".
+func (nc NestedCall) String() string {
+	return nc.Field + " via " + nc.Callee + ": " + nc.Problem
 }
 
 // FormatContext holds the context needed to format a diagnostic message.
@@ -25,6 +44,7 @@ type ConverterValidationResult struct {
 type FormatContext struct {
 	Filename   string
 	Fn         *ast.FuncDecl
+	Return     *ast.ReturnStmt // the offending return of a path-sensitive finding, or nil
 	Pass       *analysis.Pass
 	Validation *ConverterValidationResult
 	Verbose    bool
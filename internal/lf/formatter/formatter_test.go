@@ -79,6 +79,18 @@ func buildFormatContext(
 	}
 }
 
+// firstReturn returns the first return statement in fn.
+func firstReturn(fn *ast.FuncDecl) *ast.ReturnStmt {
+	var ret *ast.ReturnStmt
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		if r, ok := n.(*ast.ReturnStmt); ok && ret == nil {
+			ret = r
+		}
+		return ret == nil
+	})
+	return ret
+}
+
 func TestDefaultFormat(t *testing.T) {
 	g := NewWithT(t)
 
@@ -116,6 +128,62 @@ func TestDefaultFormat(t *testing.T) {
 		g.Expect(out).To(be.Eq("ConvertUser: incomplete converter"))
 	})
 
+	t.Run("path-sensitive finding names its return", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := buildFormatContext(t, &formatter.ConverterValidationResult{
+			ConverterType:       "converter",
+			MissingOutputFields: []string{"Email"},
+		})
+		ctx.Return = firstReturn(ctx.Fn)
+
+		out := formatter.New(formatter.FormatterDefault).Format(ctx)
+		g.Expect(out).To(be.Eq("ConvertUser (return at line 14): incomplete converter with missing fields: Email"))
+	})
+
+	t.Run("reflective copy is told apart", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := buildFormatContext(t, &formatter.ConverterValidationResult{
+			ConverterType:       "reflective copy",
+			MissingInputFields:  []string{"u.Login"},
+			MissingOutputFields: []string{"Username"},
+			CopyCall:            "copier.Copy",
+		})
+
+		out := formatter.New(formatter.FormatterDefault).Format(ctx)
+		g.Expect(out).To(be.Eq(
+			"ConvertUser: unchecked reflective copy (copier.Copy) with missing fields: u.Login, Username"))
+	})
+
+	t.Run("nested converter calls follow the kind", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := buildFormatContext(t, &formatter.ConverterValidationResult{
+			ConverterType:       "converter",
+			MissingOutputFields: []string{"Email"},
+			NestedCalls: []formatter.NestedCall{
+				{Field: "Role", Callee: "toRoleDTO", Problem: "incomplete converter"},
+				{Field: "Owner", Callee: "mapping.MapOwner", Problem: "not a converter"},
+			},
+		})
+
+		out := formatter.New(formatter.FormatterDefault).Format(ctx)
+		g.Expect(out).To(be.Eq("ConvertUser: incomplete converter (Role via toRoleDTO: incomplete converter, " +
+			"Owner via mapping.MapOwner: not a converter) with missing fields: Email"))
+	})
+
+	t.Run("dataflow findings follow the missing fields", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := buildFormatContext(t, &formatter.ConverterValidationResult{
+			ConverterType:        "converter",
+			MissingOutputFields:  []string{"Status"},
+			ConstantOutputFields: []string{"Email"},
+			LostInputFields:      []string{"u.Email"},
+		})
+
+		out := formatter.New(formatter.FormatterDefault).Format(ctx)
+		g.Expect(out).To(be.Eq("ConvertUser: incomplete converter with missing fields: Status; " +
+			"fields set only from constants: Email; fields reaching no output: u.Email"))
+	})
+
 	// The default format must stay single-line and ANSI-free: it is the format
 	// consumed by go vet -json, editors and golangci-lint.
 	ctx := buildFormatContext(t, &formatter.ConverterValidationResult{
@@ -214,6 +282,51 @@ func TestPrettyFormat(t *testing.T) {
 		g.Expect(out).To(be_string.ContainingSubstring("[2/5]"))
 	})
 
+	t.Run("path-sensitive finding points at its return", func(t *testing.T) {
+		g := NewWithT(t)
+		t.Setenv("NO_COLOR", "1")
+
+		ctx := buildFormatContext(t,
+			newValidation(nil, []string{"Email"}))
+		ctx.Return = firstReturn(ctx.Fn)
+
+		out := formatter.New(formatter.FormatterPretty).Format(ctx)
+		g.Expect(out).To(be.All(
+			be_string.ContainingSubstring("14 |"),
+			be_string.ContainingSubstring("return UserDTO{ID: u.ID}"),
+			be_string.ContainingSubstring("^^^^^^ detected as converter"),
+		))
+	})
+
+	t.Run("nested converter calls get a note each", func(t *testing.T) {
+		g := NewWithT(t)
+		t.Setenv("NO_COLOR", "1")
+
+		validation := newValidation(nil, nil)
+		validation.NestedCalls = []formatter.NestedCall{
+			{Field: "Role", Callee: "toRoleDTO", Problem: "incomplete converter"},
+		}
+		ctx := buildFormatContext(t, validation)
+
+		out := formatter.New(formatter.FormatterPretty).Format(ctx)
+		g.Expect(out).To(be_string.ContainingSubstring("= note: Role via toRoleDTO: incomplete converter"))
+		g.Expect(out).NotTo(be_string.ContainingSubstring("missing fields"))
+	})
+
+	t.Run("dataflow findings get a note each", func(t *testing.T) {
+		g := NewWithT(t)
+		t.Setenv("NO_COLOR", "1")
+
+		validation := newValidation(nil, nil)
+		validation.ConstantOutputFields = []string{"Email"}
+		validation.LostInputFields = []string{"u.Email"}
+		ctx := buildFormatContext(t, validation)
+
+		out := formatter.New(formatter.FormatterPretty).Format(ctx)
+		g.Expect(out).To(be_string.ContainingSubstring("= note: set only from constants: Email"))
+		g.Expect(out).To(be_string.ContainingSubstring("= note: reaching no output: u.Email"))
+	})
+
 	t.Run("survives unreadable source file", func(t *testing.T) {
 		g := NewWithT(t)
 		t.Setenv("NO_COLOR", "1")
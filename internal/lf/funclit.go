@@ -0,0 +1,150 @@
+package lf
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"slices"
+)
+
+// literalDecls returns a declaration for every function literal of file, keyed by the
+// literal, so literals go through the same converter checks as declared functions:
+// "var toDTO = func(u User) UserDTO {...}", closures handed to mapping helpers, and the
+// entries of dispatch tables like "map[Kind]func(User) UserDTO{KindUser: func...}".
+//
+// The declaration shares the literal's type and body. Its name is synthesized from
+// where the literal is bound (see literalName) and positioned at the func keyword, so
+// diagnostics point at the literal. It has no object in TypesInfo.Defs: funcSignature
+// reads the signature off the literal's type instead.
+func literalDecls(file *ast.File, info *types.Info) map[*ast.FuncLit]*ast.FuncDecl {
+	decls := make(map[*ast.FuncLit]*ast.FuncDecl)
+	ast.PreorderStack(file, nil, func(n ast.Node, stack []ast.Node) bool {
+		lit, ok := n.(*ast.FuncLit)
+		if !ok {
+			return true
+		}
+		name, doc := literalName(stack, lit, info)
+		decls[lit] = &ast.FuncDecl{
+			Doc:  doc,
+			Name: &ast.Ident{NamePos: lit.Pos(), Name: name},
+			Type: lit.Type,
+			Body: lit.Body,
+		}
+		return true
+	})
+	return decls
+}
+
+// literalName synthesizes the name of a function literal from its ancestors (stack,
+// innermost last): the variable or table entry it is bound to when there is one
+// ("toDTO", "converters[KindUser]", "h.ToDTO"), otherwise where it appears
+// ("func literal passed to lo.Map", "func literal in Sync"). The doc comment of a
+// variable declaration is returned too, so directives work on "var toDTO = func...".
+func literalName(stack []ast.Node, lit *ast.FuncLit, info *types.Info) (string, *ast.CommentGroup) {
+	if name, doc := bindingName(stack, lit, info); name != "" {
+		return name, doc
+	}
+	if len(stack) > 0 {
+		if call, ok := stack[len(stack)-1].(*ast.CallExpr); ok && slices.Contains(call.Args, ast.Expr(lit)) {
+			return "func literal passed to " + types.ExprString(call.Fun), nil
+		}
+	}
+	for i := len(stack) - 1; i >= 0; i-- {
+		if decl, ok := stack[i].(*ast.FuncDecl); ok {
+			return "func literal in " + decl.Name.Name, nil
+		}
+	}
+	return "func literal", nil
+}
+
+// bindingName returns the name expr is bound to: the variable it initializes or is
+// assigned to, or the entry it fills in a composite literal that is itself bound.
+// It returns "" for an unbound expression.
+func bindingName(stack []ast.Node, expr ast.Expr, info *types.Info) (string, *ast.CommentGroup) {
+	if len(stack) == 0 {
+		return "", nil
+	}
+	parent, rest := stack[len(stack)-1], stack[:len(stack)-1]
+
+	switch p := parent.(type) {
+	case *ast.ParenExpr:
+		return bindingName(rest, p, info)
+	case *ast.UnaryExpr:
+		// &Handlers{...}: the table is bound through its address.
+		return bindingName(rest, p, info)
+	case *ast.ValueSpec:
+		i := slices.Index(p.Values, expr)
+		if i < 0 || i >= len(p.Names) {
+			return "", nil
+		}
+		doc := p.Doc
+		if gd, ok := outerGenDecl(rest); doc == nil && ok && !gd.Lparen.IsValid() {
+			doc = gd.Doc
+		}
+		return p.Names[i].Name, doc
+	case *ast.AssignStmt:
+		i := slices.Index(p.Rhs, expr)
+		if i < 0 || len(p.Lhs) != len(p.Rhs) {
+			return "", nil
+		}
+		return types.ExprString(p.Lhs[i]), nil
+	case *ast.KeyValueExpr:
+		if p.Value != expr || len(rest) == 0 {
+			return "", nil
+		}
+		cl, ok := rest[len(rest)-1].(*ast.CompositeLit)
+		if !ok {
+			return "", nil
+		}
+		table, _ := bindingName(rest[:len(rest)-1], cl, info)
+		if table == "" {
+			return "", nil
+		}
+		if isStructLit(cl, info) {
+			return table + "." + types.ExprString(p.Key), nil
+		}
+		return table + "[" + types.ExprString(p.Key) + "]", nil
+	case *ast.CompositeLit:
+		i := slices.Index(p.Elts, expr)
+		if i < 0 {
+			return "", nil
+		}
+		table, _ := bindingName(rest, p, info)
+		if table == "" {
+			return "", nil
+		}
+		if st, ok := structOfLit(p, info); ok && i < st.NumFields() {
+			return table + "." + st.Field(i).Name(), nil
+		}
+		return fmt.Sprintf("%s[%d]", table, i), nil
+	}
+	return "", nil
+}
+
+// outerGenDecl returns the declaration directly enclosing a value spec.
+func outerGenDecl(stack []ast.Node) (*ast.GenDecl, bool) {
+	if len(stack) == 0 {
+		return nil, false
+	}
+	gd, ok := stack[len(stack)-1].(*ast.GenDecl)
+	return gd, ok
+}
+
+// isStructLit reports whether cl builds a struct (its keys are field names).
+func isStructLit(cl *ast.CompositeLit, info *types.Info) bool {
+	_, ok := structOfLit(cl, info)
+	return ok
+}
+
+// structOfLit returns the struct type built by cl, if it builds one.
+func structOfLit(cl *ast.CompositeLit, info *types.Info) (*types.Struct, bool) {
+	t := info.TypeOf(cl)
+	if t == nil {
+		return nil, false
+	}
+	if ptr, ok := t.Underlying().(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	st, ok := t.Underlying().(*types.Struct)
+	return st, ok
+}
@@ -0,0 +1,256 @@
+package lf
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// varTarget is the variable a collector follows. With type information it is told by
+// its objects (see resolveVar); without, any identifier of its name stands for it.
+type varTarget struct {
+	name    string
+	info    *types.Info
+	objs    map[types.Object]bool
+	aliases map[types.Object]alias
+}
+
+// alias is a local variable standing for a field chain of the target (u := in.User for
+// User, role := in.User.Role for User.Role), or for the target itself (p := &out).
+// shared tells whether it reaches the target's own value - taken by address or held by
+// pointer - so that writes through it land in the target, rather than in a copy.
+type alias struct {
+	chain  string
+	shared bool
+}
+
+// aliasOf returns the alias id denotes, if it is one.
+func (t varTarget) aliasOf(id *ast.Ident) (alias, bool) {
+	if t.info == nil {
+		return alias{}, false
+	}
+	a, ok := t.aliases[t.info.ObjectOf(id)]
+	return a, ok
+}
+
+// is reports whether id denotes the variable.
+func (t varTarget) is(id *ast.Ident) bool {
+	if t.name == "" || id.Name != t.name {
+		return false
+	}
+	if t.info == nil {
+		return true
+	}
+	return t.objs[t.info.ObjectOf(id)]
+}
+
+// ref reports whether expr, seen through &, * and parentheses, is the variable.
+func (t varTarget) ref(expr ast.Expr) bool {
+	switch x := expr.(type) {
+	case *ast.Ident:
+		return t.is(x)
+	case *ast.UnaryExpr:
+		return x.Op == token.AND && t.ref(x.X)
+	case *ast.StarExpr:
+		return t.ref(x.X)
+	case *ast.ParenExpr:
+		return t.ref(x.X)
+	}
+	return false
+}
+
+// usedIn reports whether the variable is referenced anywhere in n.
+func (t varTarget) usedIn(n ast.Node) bool {
+	var found bool
+	ast.Inspect(n, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && t.is(id) {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// resolveVar resolves name, followed through n, to the variables it denotes there: the
+// one in scope where n starts, else those declared in n (the loop variable of a range)
+// other than closure parameters and their own shadows.
+func resolveVar(info *types.Info, n ast.Node, name string) varTarget {
+	t := varTarget{name: name, info: info}
+	if info == nil || name == "" || name == "_" || n == nil {
+		return t
+	}
+	start := n.Pos()
+	if fn, ok := n.(*ast.FuncDecl); ok && fn.Body != nil {
+		start = fn.Body.Pos()
+	}
+
+	var candidates []*types.Var
+	closureParams := make(map[types.Object]bool)
+	ast.Inspect(n, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.FuncLit:
+			for _, list := range []*ast.FieldList{x.Type.Params, x.Type.Results} {
+				if list == nil {
+					continue
+				}
+				for _, field := range list.List {
+					for _, id := range field.Names {
+						closureParams[info.Defs[id]] = true
+					}
+				}
+			}
+		case *ast.Ident:
+			if v, isVar := info.ObjectOf(x).(*types.Var); isVar && x.Name == name && !v.IsField() &&
+				!slices.Contains(candidates, v) {
+				candidates = append(candidates, v)
+			}
+		}
+		return true
+	})
+
+	t.objs = make(map[types.Object]bool)
+	// The variable the name denotes where n starts counts even if n never refers to it:
+	// a parameter only shadowed in the body.
+	var visible *types.Var
+	if len(candidates) > 0 {
+		visible = lookupVar(candidates[0].Pkg(), name, start)
+	}
+	for _, v := range candidates {
+		if v.Parent() != nil && v.Parent().Contains(start) &&
+			(visible == nil || scopeWithin(v.Parent(), visible.Parent())) {
+			visible = v
+		}
+	}
+	if visible != nil {
+		t.objs[visible] = true
+	} else {
+		for _, v := range candidates {
+			if closureParams[v] {
+				continue
+			}
+			shadows := slices.ContainsFunc(candidates, func(o *types.Var) bool {
+				return o != v && !closureParams[o] && o.Parent() != v.Parent() && scopeWithin(v.Parent(), o.Parent())
+			})
+			if !shadows {
+				t.objs[v] = true
+			}
+		}
+	}
+	t.aliases = findAliases(n, t)
+	return t
+}
+
+// lookupVar returns the variable name denotes at pos in pkg, or nil.
+func lookupVar(pkg *types.Package, name string, pos token.Pos) *types.Var {
+	if pkg == nil {
+		return nil
+	}
+	s := pkg.Scope().Innermost(pos)
+	if s == nil {
+		return nil
+	}
+	_, obj := s.LookupParent(name, pos)
+	if v, isVar := obj.(*types.Var); isVar && !v.IsField() {
+		return v
+	}
+	return nil
+}
+
+// scopeWithin reports whether inner is outer or nested in it.
+func scopeWithin(inner, outer *types.Scope) bool {
+	for s := inner; s != nil; s = s.Parent() {
+		if s == outer {
+			return true
+		}
+	}
+	return false
+}
+
+// findAliases returns the aliases of t declared in n: the local variables initialized
+// with a field chain of t or of another alias (u := in.User, addr := &out.Address,
+// role := u.Role), in a short variable declaration or a var declaration. A variable
+// assigned anything afterwards is not an alias, as it may stand for another value.
+func findAliases(n ast.Node, t varTarget) map[types.Object]alias {
+	aliases := make(map[types.Object]alias)
+	reassigned := make(map[types.Object]bool)
+	declare := func(names []*ast.Ident, values []ast.Expr) {
+		if len(names) != len(values) {
+			return
+		}
+		for i, name := range names {
+			v, isVar := t.info.Defs[name].(*types.Var)
+			if !isVar || t.objs[v] {
+				continue
+			}
+			if a, ok := t.aliasFor(aliases, values[i]); ok {
+				aliases[v] = a
+			}
+		}
+	}
+	ast.Inspect(n, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.AssignStmt:
+			var names []*ast.Ident
+			for _, lhs := range x.Lhs {
+				id, isIdent := lhs.(*ast.Ident)
+				if !isIdent {
+					continue
+				}
+				if x.Tok == token.DEFINE && t.info.Defs[id] != nil {
+					names = append(names, id)
+				} else {
+					reassigned[t.info.ObjectOf(id)] = true
+				}
+			}
+			if x.Tok == token.DEFINE && len(names) == len(x.Lhs) {
+				declare(names, x.Rhs)
+			}
+		case *ast.ValueSpec:
+			declare(x.Names, x.Values)
+		}
+		return true
+	})
+	maps.DeleteFunc(aliases, func(obj types.Object, _ alias) bool { return reassigned[obj] })
+	return aliases
+}
+
+// aliasFor returns the alias a variable initialized with expr is, given the aliases
+// known so far: expr selects a field chain of the target or of one of them, seen
+// through indexing, dereference and parentheses, or takes its address.
+func (t varTarget) aliasFor(aliases map[types.Object]alias, expr ast.Expr) (alias, bool) {
+	base := unwrapBase(expr)
+	addressed := false
+	if u, ok := base.(*ast.UnaryExpr); ok && u.Op == token.AND {
+		base, addressed = unwrapBase(u.X), true
+	}
+	var path []string
+	for {
+		switch x := base.(type) {
+		case *ast.SelectorExpr:
+			if sel, ok := t.info.Selections[x]; !ok || sel.Kind() != types.FieldVal {
+				return alias{}, false
+			}
+			path = append([]string{x.Sel.Name}, path...)
+			base = unwrapBase(x.X)
+			continue
+		case *ast.Ident:
+			of := alias{shared: true}
+			if !t.is(x) {
+				var ok bool
+				if of, ok = aliases[t.info.ObjectOf(x)]; !ok {
+					return alias{}, false
+				}
+			}
+			chain := of.chain
+			if len(path) > 0 {
+				chain = joinPath(of.chain, strings.Join(path, "."))
+			}
+			_, isPointer := t.info.TypeOf(expr).Underlying().(*types.Pointer)
+			return alias{chain: chain, shared: isPointer || addressed && of.shared}, true
+		}
+		return alias{}, false
+	}
+}
@@ -0,0 +1,208 @@
+package lf
+
+import (
+	"cmp"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// mappingTagKeys are the struct tags whose names pair an output field with an input
+// field of another name: Contact `json:"email"` corresponds to Email `json:"email"`.
+var mappingTagKeys = []string{"json", "yaml", "xml", "db", "bson", "mapstructure"}
+
+// SuspiciousMapping is an output field taken straight from an input field other than the
+// one corresponding to it (see suspiciousMappings).
+type SuspiciousMapping struct {
+	// Pos is where the output field is set: its key in a literal, or the assignment.
+	Pos token.Pos
+	// Field is the output field, prefixed like the missing output fields.
+	Field string
+	// Source is the input field it is taken from ("u.LastName").
+	Source string
+	// Expected is the input field corresponding to Field ("u.FirstName").
+	Expected string
+	// SwappedWith is the output field taking Source's counterpart, Expected, when the two
+	// are swapped; empty when Expected is left unused instead.
+	SwappedWith string
+}
+
+// message describes the mapping for a diagnostic of the converter fnName.
+func (m SuspiciousMapping) message(fnName string) string {
+	if m.SwappedWith != "" {
+		return fmt.Sprintf("%s: suspicious mapping: %s and %s look swapped (%s from %s, %s from %s)",
+			fnName, m.Field, m.SwappedWith, m.Field, m.Source, m.SwappedWith, m.Expected)
+	}
+	return fmt.Sprintf("%s: suspicious mapping: %s set from %s while %s is not used",
+		fnName, m.Field, m.Source, m.Expected)
+}
+
+// fieldMapping is an output field set straight from a field of an input variable.
+type fieldMapping struct {
+	pos    token.Pos
+	field  string
+	inVar  string
+	source string
+}
+
+// suspiciousMappings returns the mappings of fn into one output - keys of lits and fields
+// of outVar set straight from an input field (Email: in.Name, out.Email = in.Name) - that
+// take another input field than the one corresponding to the output field (same name, or
+// one of mappingTagKeys naming both alike). Two output fields taking each other's field
+// are reported once, as swapped; any other such mapping only when the corresponding field
+// is among missingIn, so a field deliberately mapped elsewhere stays quiet. inputs holds
+// the struct of each input variable, prefix the one qualifying the output fields.
+func suspiciousMappings(
+	info *types.Info,
+	fn *ast.FuncDecl,
+	outStruct *types.Struct,
+	outVar string,
+	lits []*ast.CompositeLit,
+	inputs map[string]*types.Struct,
+	missingIn []string,
+	prefix string,
+) []SuspiciousMapping {
+	inVars := make(map[string]varTarget, len(inputs))
+	for name := range inputs {
+		inVars[name] = resolveVar(info, fn.Body, name)
+	}
+	output := resolveVar(info, fn.Body, outVar)
+	var mappings []fieldMapping
+	add := func(pos token.Pos, field string, value ast.Expr) {
+		sel, ok := ast.Unparen(value).(*ast.SelectorExpr)
+		if !ok {
+			return
+		}
+		id, ok := sel.X.(*ast.Ident)
+		if !ok || inputs[id.Name] == nil || !inVars[id.Name].is(id) {
+			return
+		}
+		if _, found := structField(inputs[id.Name], sel.Sel.Name); found {
+			mappings = append(mappings, fieldMapping{pos: pos, field: field, inVar: id.Name, source: sel.Sel.Name})
+		}
+	}
+	for _, cl := range lits {
+		for _, elt := range cl.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				if key, isIdent := kv.Key.(*ast.Ident); isIdent {
+					add(kv.Pos(), key.Name, kv.Value)
+				}
+			}
+		}
+	}
+	if outVar != "" {
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			assign, ok := n.(*ast.AssignStmt)
+			if !ok || len(assign.Lhs) != len(assign.Rhs) {
+				return true
+			}
+			for i, lhs := range assign.Lhs {
+				if sel, isSel := lhs.(*ast.SelectorExpr); isSel && output.ref(sel.X) {
+					add(lhs.Pos(), sel.Sel.Name, assign.Rhs[i])
+				}
+			}
+			return true
+		})
+	}
+
+	// expected returns the input field corresponding to the output field of m, if another
+	// than the one it is taken from.
+	expected := func(m fieldMapping) (string, bool) {
+		outTag, found := structField(outStruct, m.field)
+		if !found {
+			return "", false
+		}
+		want, ok := correspondingField(inputs[m.inVar], m.field, outTag)
+		if !ok || want == m.source {
+			return "", false
+		}
+		srcTag, _ := structField(inputs[m.inVar], m.source)
+		if sharesTagName(srcTag, outTag) {
+			return "", false
+		}
+		return want, true
+	}
+
+	var res []SuspiciousMapping
+	swapped := make(map[int]bool)
+	for i, m := range mappings {
+		want, ok := expected(m)
+		if !ok || swapped[i] {
+			continue
+		}
+		found := SuspiciousMapping{
+			Pos:      m.pos,
+			Field:    joinPath(prefix, m.field),
+			Source:   m.inVar + "." + m.source,
+			Expected: m.inVar + "." + want,
+		}
+		other := slices.IndexFunc(mappings, func(o fieldMapping) bool {
+			if o.inVar != m.inVar || o.source != want {
+				return false
+			}
+			back, isSuspicious := expected(o)
+			return isSuspicious && back == m.source
+		})
+		switch {
+		case other >= 0:
+			swapped[other] = true
+			found.SwappedWith = joinPath(prefix, mappings[other].field)
+		case !slices.Contains(missingIn, found.Expected):
+			continue
+		}
+		res = append(res, found)
+	}
+	slices.SortStableFunc(res, func(a, b SuspiciousMapping) int { return cmp.Compare(a.Pos, b.Pos) })
+	return res
+}
+
+// correspondingField returns the field of st corresponding to the output field name with
+// the struct tag outTag: the one of the same name (case aside), or else the one sharing
+// its name in one of mappingTagKeys.
+func correspondingField(st *types.Struct, name, outTag string) (string, bool) {
+	for i := range st.NumFields() {
+		if strings.EqualFold(st.Field(i).Name(), name) {
+			return st.Field(i).Name(), true
+		}
+	}
+	for i := range st.NumFields() {
+		if sharesTagName(st.Tag(i), outTag) {
+			return st.Field(i).Name(), true
+		}
+	}
+	return "", false
+}
+
+// sharesTagName reports whether two struct tags give the same name under one of
+// mappingTagKeys.
+func sharesTagName(a, b string) bool {
+	return slices.ContainsFunc(mappingTagKeys, func(key string) bool {
+		name := tagName(a, key)
+		return name != "" && name == tagName(b, key)
+	})
+}
+
+// tagName returns the name a struct tag gives under key (`json:"email,omitempty"` →
+// email), or "" when it gives none or skips the field ("-").
+func tagName(tag, key string) string {
+	value, _ := reflect.StructTag(tag).Lookup(key)
+	name, _, _ := strings.Cut(value, ",")
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+// structField reports whether st has a field named name, and returns its struct tag.
+func structField(st *types.Struct, name string) (string, bool) {
+	for i := range st.NumFields() {
+		if st.Field(i).Name() == name {
+			return st.Tag(i), true
+		}
+	}
+	return "", false
+}
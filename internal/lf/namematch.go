@@ -0,0 +1,117 @@
+package lf
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/amberpixels/lostfield/internal/config"
+)
+
+// typeNamesMatch reports whether two candidate match names pair up under the configured
+// name-matching strategy and the min-similarity floor on top of it.
+//
+// Matching is the gate; min-similarity is a floor on top of it, never an alternative to
+// it. Keeping it that way is what makes the setting monotonic: raising it can only ever
+// narrow the set of pairs. The two are not otherwise comparable - a bigram score alone
+// happily matches ImportLocationOptions to ImportLocationResult on their shared prefix,
+// which containment is right to reject.
+func typeNamesMatch(in, out string, cfg *config.Config) bool {
+	if cfg.NameMatching == config.NameMatchingTokens {
+		affixes := affixTokens(cfg.NameAffixes)
+		inTokens := stripAffixes(nameTokens(in), affixes)
+		outTokens := stripAffixes(nameTokens(out), affixes)
+		if !tokenContainment(inTokens, outTokens) {
+			return false
+		}
+		in, out = strings.Join(inTokens, ""), strings.Join(outTokens, "")
+	} else if !nameContainment(in, out) {
+		return false
+	}
+	return cfg.MinTypeNameSimilarity <= 0 || typeNameSimilarity(in, out) >= cfg.MinTypeNameSimilarity
+}
+
+// nameTokens splits a type name into lowercased CamelCase tokens. An acronym stays one
+// token and ends where the next word starts ("PBUser" → pb, user; "HTTPRequest" → http,
+// request); digits stay with the token before them ("V2User" → v2, user) and underscores
+// separate tokens.
+func nameTokens(name string) []string {
+	runes := []rune(name)
+	var tokens []string
+	start := 0
+	flush := func(end int) {
+		if end > start {
+			tokens = append(tokens, strings.ToLower(string(runes[start:end])))
+		}
+		start = end
+	}
+	for i, r := range runes {
+		if r == '_' {
+			flush(i)
+			start = i + 1
+			continue
+		}
+		if i == start || !unicode.IsUpper(r) {
+			continue
+		}
+		prev := runes[i-1]
+		nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+		if !unicode.IsUpper(prev) || nextLower {
+			flush(i)
+		}
+	}
+	flush(len(runes))
+	return tokens
+}
+
+// affixTokens tokenizes the configured affixes, so "NewParams" strips as two tokens.
+func affixTokens(affixes []string) [][]string {
+	res := make([][]string, 0, len(affixes))
+	for _, a := range affixes {
+		if tokens := nameTokens(a); len(tokens) > 0 {
+			res = append(res, tokens)
+		}
+	}
+	return res
+}
+
+// stripAffixes removes affixes from both ends of tokens, repeatedly, but never strips a
+// name to nothing: "PBUserDTO" → user, while "DTO" stays dto.
+func stripAffixes(tokens []string, affixes [][]string) []string {
+	for stripped := true; stripped; {
+		stripped = false
+		for _, affix := range affixes {
+			if len(affix) >= len(tokens) {
+				continue
+			}
+			switch {
+			case slices.Equal(tokens[:len(affix)], affix):
+				tokens = tokens[len(affix):]
+			case slices.Equal(tokens[len(tokens)-len(affix):], affix):
+				tokens = tokens[:len(tokens)-len(affix)]
+			default:
+				continue
+			}
+			stripped = true
+		}
+	}
+	return tokens
+}
+
+// tokenContainment reports whether one token sequence appears, contiguously and as whole
+// tokens, in the other. Like nameContainment, the shared name must be at least 3 chars.
+func tokenContainment(a, b []string) bool {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	const minSharedLen = 3
+	if len(strings.Join(a, "")) < minSharedLen {
+		return false
+	}
+	for i := 0; i+len(a) <= len(b); i++ {
+		if slices.Equal(b[i:i+len(a)], a) {
+			return true
+		}
+	}
+	return false
+}
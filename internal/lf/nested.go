@@ -0,0 +1,139 @@
+package lf
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"maps"
+	"slices"
+
+	"github.com/amberpixels/lostfield/internal/config"
+)
+
+// nestedScope is where collectMissingFieldsWithPrefix stands in the type it checks: the
+// structs entered on the way down from the converted one, embeds included, and how many
+// of those are nested fields rather than embeds.
+type nestedScope struct {
+	path  []*types.Struct
+	level int
+}
+
+// enter returns the scope inside st, one level deeper unless st is embedded.
+func (s nestedScope) enter(st *types.Struct, embedded bool) nestedScope {
+	next := nestedScope{path: append(slices.Clip(s.path), st), level: s.level}
+	if !embedded {
+		next.level++
+	}
+	return next
+}
+
+// entered reports how many times st is already being checked on this path: more than
+// once from the start for a self-referential type (Manager *User, an embedded *Node).
+func (s nestedScope) entered(st *types.Struct) int {
+	n := 0
+	for _, p := range s.path {
+		if types.Identical(p, st) {
+			n++
+		}
+	}
+	return n
+}
+
+// withinDepth reports whether nested-depth allows checking a nested struct one level
+// below the scope without a chain into it.
+func (s nestedScope) withinDepth(cfg *config.Config) bool {
+	return cfg.NestedDepth < 0 || s.level < cfg.NestedDepth
+}
+
+// collectOpenedFields returns, when nested-depth is set, the nested paths of varName the
+// converter handles piecewise rather than whole: built from a literal (out.Owner =
+// &UserDTO{}, or Owner: &UserDTO{} in one of lits) or only compared to nil
+// (in.Owner != nil). A path also read or written whole anywhere - User: in.User,
+// ToUserDTO(in.Owner), out.Owner = owner - is left out: that hands the struct on.
+func collectOpenedFields(
+	cfg *config.Config,
+	info *types.Info,
+	n ast.Node,
+	varName string,
+	lits []*ast.CompositeLit,
+) UsageLookup {
+	if cfg.NestedDepth == 0 {
+		return nil
+	}
+	piecewise, whole := make(UsageLookup), make(UsageLookup)
+	if varName != "" {
+		chains := newChainCollector(info, n, varName)
+		ast.PreorderStack(n, nil, func(n ast.Node, stack []ast.Node) bool {
+			sel, ok := n.(*ast.SelectorExpr)
+			if !ok || len(stack) == 0 {
+				return true
+			}
+			chain, shared := chains.fieldChain(sel)
+			if chain == "" {
+				return true
+			}
+			switch parent := stack[len(stack)-1].(type) {
+			case *ast.SelectorExpr:
+				return true // the chain goes on: in.Owner.ID
+			case *ast.CallExpr:
+				if parent.Fun == sel {
+					return true // a method call
+				}
+				whole.Add(chain)
+			case *ast.BinaryExpr:
+				if (parent.Op == token.EQL || parent.Op == token.NEQ) &&
+					(isNilIdent(parent.X) || isNilIdent(parent.Y)) {
+					piecewise.Add(chain)
+				} else {
+					whole.Add(chain)
+				}
+			case *ast.AssignStmt:
+				i := slices.Index(parent.Lhs, ast.Expr(sel))
+				if i >= 0 && !shared {
+					return true // a write to a copy
+				}
+				if i < 0 || len(parent.Lhs) != len(parent.Rhs) {
+					whole.Add(chain)
+				} else if cl := unwrapCompositeLit(ast.Unparen(parent.Rhs[i])); cl != nil {
+					piecewise.Add(chain)
+					openedLiteralKeys(cl, chain, piecewise, whole)
+				} else {
+					whole.Add(chain)
+				}
+			default:
+				whole.Add(chain)
+			}
+			return true
+		})
+	}
+	for _, cl := range lits {
+		openedLiteralKeys(cl, "", piecewise, whole)
+	}
+	maps.DeleteFunc(piecewise, func(k string, _ struct{}) bool { return whole.Has(k) })
+	return piecewise
+}
+
+// openedLiteralKeys sorts the keys of cl, under prefix, into those given a nested literal
+// (Owner: &UserDTO{...}), recursively, and those given any other value.
+func openedLiteralKeys(cl *ast.CompositeLit, prefix string, piecewise, whole UsageLookup) {
+	for _, elt := range cl.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		path := key.Name
+		if prefix != "" {
+			path = prefix + "." + key.Name
+		}
+		if nested := unwrapCompositeLit(ast.Unparen(kv.Value)); nested != nil {
+			piecewise.Add(path)
+			openedLiteralKeys(nested, path, piecewise, whole)
+		} else {
+			whole.Add(path)
+		}
+	}
+}
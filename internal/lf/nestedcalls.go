@@ -0,0 +1,309 @@
+package lf
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+
+	"github.com/amberpixels/lostfield/internal/lf/formatter"
+)
+
+// NestedCallProblem tells what keeps the callee of a nested converter call from
+// vouching for the field it fills.
+type NestedCallProblem string
+
+const (
+	// NestedCallIncomplete is a callee that is itself an incomplete converter.
+	NestedCallIncomplete NestedCallProblem = "incomplete converter"
+	// NestedCallNotConverter is a callee lostfield does not recognize as a converter, so
+	// nothing checks what it maps.
+	NestedCallNotConverter NestedCallProblem = "not a converter"
+)
+
+// NestedCall is an output field a converter fills by converting a struct of its input
+// with another function - Role: toRoleDTO(in.Role) - whose callee is not a complete
+// converter.
+type NestedCall struct {
+	// Field is the output field path the call fills ("Role", "User.Role").
+	Field string
+	// Callee is the function called.
+	Callee *types.Func
+	// Name is the callee as reported: "toRoleDTO", "mapping.ToRoleDTO", "Role.ToDTO".
+	Name string
+	// Problem tells what is wrong with the callee.
+	Problem NestedCallProblem
+	// Missing lists the fields the callee leaves out, for an incomplete converter.
+	Missing []string
+}
+
+// nestedConverterCalls returns the nested converter calls of fn whose callee is not a
+// complete converter. A nested converter call fills an output field - a key of one of
+// lits or a field of outVar - with a call converting a struct reached from one of inVars
+// (Role: toRoleDTO(in.Role), out.Owner = mapOwner(in.Owner), Role: in.Role.ToDTO()),
+// between types lostfield would pair itself (see isConverterPair).
+func (h *helperSummaries) nestedConverterCalls(
+	fn *ast.FuncDecl,
+	inVars []string,
+	outVar string,
+	lits []*ast.CompositeLit,
+) []NestedCall {
+	var inputs []*UsageCollector
+	for _, v := range inVars {
+		if v != "" {
+			inputs = append(inputs, newChainCollector(h.pass.TypesInfo, fn.Body, v))
+		}
+	}
+	var res []NestedCall
+	seen := make(map[*ast.CallExpr]bool)
+	var visit func(path string, value ast.Expr)
+	visit = func(path string, value ast.Expr) {
+		if cl := unwrapCompositeLit(ast.Unparen(value)); cl != nil {
+			for _, elt := range cl.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+				if key, isIdent := kv.Key.(*ast.Ident); isIdent {
+					visit(joinPath(path, key.Name), kv.Value)
+				}
+			}
+			return
+		}
+		call, ok := ast.Unparen(value).(*ast.CallExpr)
+		if !ok || seen[call] || path == "" {
+			return
+		}
+		seen[call] = true
+		if nc, isNested := h.nestedCall(call, inputs); isNested {
+			nc.Field = path
+			res = append(res, nc)
+		}
+	}
+
+	for _, cl := range lits {
+		visit("", cl)
+	}
+	if outVar != "" {
+		chains := newChainCollector(h.pass.TypesInfo, fn.Body, outVar)
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			assign, ok := n.(*ast.AssignStmt)
+			if !ok || len(assign.Lhs) != len(assign.Rhs) {
+				return true
+			}
+			for i, lhs := range assign.Lhs {
+				if sel, isSel := lhs.(*ast.SelectorExpr); isSel {
+					if chain, shared := chains.fieldChain(sel); chain != "" && shared {
+						visit(chain, assign.Rhs[i])
+					}
+				}
+			}
+			return true
+		})
+	}
+	return res
+}
+
+// nestedCall reports whether call converts a struct reached from one of inputs, as an
+// argument or the receiver, and returns what is wrong with its callee (no Problem when
+// the callee is a complete converter, or unknown).
+func (h *helperSummaries) nestedCall(call *ast.CallExpr, inputs []*UsageCollector) (NestedCall, bool) {
+	callee := typeutil.StaticCallee(h.pass.TypesInfo, call)
+	if callee == nil {
+		return NestedCall{}, false
+	}
+	outCand, ok := extractCandidateType(h.pass.TypesInfo.TypeOf(call))
+	if !ok {
+		return NestedCall{}, false
+	}
+	args := slices.Clone(call.Args)
+	if sel, isSel := call.Fun.(*ast.SelectorExpr); isSel && callee.Signature().Recv() != nil {
+		args = append(args, sel.X)
+	}
+	idx := slices.IndexFunc(args, func(arg ast.Expr) bool {
+		if !isInputChain(arg, inputs) {
+			return false
+		}
+		inCand, isCand := extractCandidateType(h.pass.TypesInfo.TypeOf(arg))
+		return isCand && isConverterPair(inCand, outCand, h.cfg, false)
+	})
+	if idx < 0 {
+		return NestedCall{}, false
+	}
+	problem, missing := h.calleeProblem(callee.Origin())
+	if problem == "" {
+		return NestedCall{}, false
+	}
+	if problem == NestedCallNotConverter {
+		inCand, _ := extractCandidateType(h.pass.TypesInfo.TypeOf(args[idx]))
+		if idx == len(call.Args) {
+			idx = -1 // the receiver
+		}
+		if h.readsWhole(callee.Origin(), idx, inCand.structType) {
+			return NestedCall{}, false
+		}
+	}
+	return NestedCall{
+		Callee:  callee.Origin(),
+		Name:    calleeName(callee, h.pass.Pkg),
+		Problem: problem,
+		Missing: missing,
+	}, true
+}
+
+// readsWhole reports whether fn, a helper rather than a converter, reads every field of
+// the input struct st handed to it at position idx (-1 for the receiver), per its summary
+// or its FieldUsageFact: a constructor such as NewAddressDTO(in.Address) maps it as well
+// as a converter would.
+func (h *helperSummaries) readsWhole(fn *types.Func, idx int, st *types.Struct) bool {
+	summary, _ := h.summary(fn, 0)
+	if summary == nil || st == nil {
+		return false
+	}
+	usage := summary.recv
+	if idx >= 0 {
+		if len(summary.params) == 0 {
+			return false
+		}
+		usage = summary.params[min(idx, len(summary.params)-1)] // variadic
+	}
+	return len(collectMissingFields(st, fieldUsage{fields: usage.fields, methods: usage.methods}, h.pass, h.cfg)) == 0
+}
+
+// calleeProblem tells what is wrong with fn as a nested converter, and the fields it
+// leaves out when it is incomplete. A function of this package is checked in place
+// unless it is left out on purpose (//lostfield:ignore, a file-ignore, an excluded file
+// or name): it must be a converter, and a complete one. One from another package goes
+// by its facts: an IncompleteConverterFact marks an incomplete converter, a
+// FieldUsageFact a helper rather than a converter; without either nothing is known
+// against it.
+func (h *helperSummaries) calleeProblem(fn *types.Func) (NestedCallProblem, []string) {
+	decl, ok := h.decls[fn]
+	if !ok {
+		if fn.Pkg() == nil || fn.Pkg() == h.pass.Pkg || h.pass.ImportObjectFact == nil {
+			return "", nil
+		}
+		var fact IncompleteConverterFact
+		if h.pass.ImportObjectFact(fn, &fact) {
+			return NestedCallIncomplete, fact.Missing
+		}
+		if h.pass.ImportObjectFact(fn, new(FieldUsageFact)) {
+			return NestedCallNotConverter, nil
+		}
+		return "", nil
+	}
+	if isExcludedByConfig(decl, h.cfg) || parseFuncDirectives(decl).ignore != nil || h.fileIgnored(decl.Pos()) {
+		return "", nil
+	}
+	if !IsPossibleConverter(decl, h.pass, h.cfg) {
+		return NestedCallNotConverter, nil
+	}
+	res, err := h.validate(decl)
+	if err != nil || res == nil || !res.hasMissingFields() {
+		return "", nil
+	}
+	return NestedCallIncomplete, res.missingFields()
+}
+
+// validate validates the converter decl once per pass: Run and the nested calls leading
+// to it share the result. A converter being validated already - one reaching itself
+// through nested calls, Manager: toUserDTO(u.Manager) - yields nil and no error there,
+// and counts as complete.
+func (h *helperSummaries) validate(decl *ast.FuncDecl) (*ConverterValidationResult, error) {
+	if res, ok := h.results[decl]; ok || h.validating[decl] {
+		return res, nil
+	}
+	h.validating[decl] = true
+	defer delete(h.validating, decl)
+	res, err := validateConverter(decl, h.pass, h.cfg, h)
+	if err != nil {
+		return nil, err
+	}
+	h.results[decl] = res
+	return res, nil
+}
+
+// fileIgnored reports whether the file holding pos is left out of the analysis or
+// carries a //lostfield:file-ignore directive.
+func (h *helperSummaries) fileIgnored(pos token.Pos) bool {
+	file := fileOf(h.pass, pos)
+	if file == nil {
+		return false
+	}
+	if _, ok := fileIgnoreDirective(file); ok {
+		return true
+	}
+	return isFileExcluded(file, h.pass.Fset.Position(file.Pos()).Filename, h.cfg)
+}
+
+// isInputChain reports whether expr, seen through &, * and parentheses, selects a field
+// chain of one of inputs, the chain collectors of the converter's inputs: in.Role,
+// &in.Owner, *in.Owner.
+func isInputChain(expr ast.Expr, inputs []*UsageCollector) bool {
+	for {
+		switch x := ast.Unparen(expr).(type) {
+		case *ast.UnaryExpr:
+			if x.Op != token.AND {
+				return false
+			}
+			expr = x.X
+		case *ast.StarExpr:
+			expr = x.X
+		case *ast.SelectorExpr:
+			return slices.ContainsFunc(inputs, func(input *UsageCollector) bool {
+				return input.buildFieldChain(x) != ""
+			})
+		default:
+			return false
+		}
+	}
+}
+
+// calleeName returns how a callee is reported from pkg: its name, qualified by its
+// receiver type for a method (Role.ToDTO) and by its package when it is another one.
+func calleeName(fn *types.Func, pkg *types.Package) string {
+	name := fn.Name()
+	if recv := fn.Signature().Recv(); recv != nil {
+		t := recv.Type()
+		if ptr, ok := types.Unalias(t).(*types.Pointer); ok {
+			t = ptr.Elem()
+		}
+		if named, ok := types.Unalias(t).(*types.Named); ok {
+			name = named.Obj().Name() + "." + name
+		}
+	}
+	if fn.Pkg() != nil && fn.Pkg() != pkg {
+		name = fn.Pkg().Name() + "." + name
+	}
+	return name
+}
+
+// formatNestedCalls hands the nested calls over to the formatter.
+func formatNestedCalls(calls []NestedCall) []formatter.NestedCall {
+	var res []formatter.NestedCall
+	for _, nc := range calls {
+		res = append(res, formatter.NestedCall{Field: nc.Field, Callee: nc.Name, Problem: string(nc.Problem)})
+	}
+	return res
+}
+
+// nestedCallsRelated links a diagnostic to the callees of its nested calls declared where
+// a position is known, with the fields an incomplete one leaves out.
+func nestedCallsRelated(calls []NestedCall) []analysis.RelatedInformation {
+	var res []analysis.RelatedInformation
+	for _, nc := range calls {
+		if !nc.Callee.Pos().IsValid() {
+			continue
+		}
+		msg := nc.Name + ": " + string(nc.Problem)
+		if len(nc.Missing) > 0 {
+			msg += " with missing fields: " + strings.Join(nc.Missing, ", ")
+		}
+		res = append(res, analysis.RelatedInformation{Pos: nc.Callee.Pos(), Message: msg})
+	}
+	return res
+}
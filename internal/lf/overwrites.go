@@ -0,0 +1,389 @@
+package lf
+
+import (
+	"cmp"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"maps"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/cfg"
+)
+
+// OverwrittenField is an output field assigned more than once on the same path, the
+// value of the first assignment never being used: Status: "new" in the literal, then
+// out.Status = in.Status, or two out.Status = lines where one was meant for another field.
+type OverwrittenField struct {
+	// Field is the output field, prefixed like the missing output fields.
+	Field string
+	// Lost holds the positions of the assignments whose value is always overwritten.
+	Lost []token.Pos
+	// Overwrites holds the positions of the assignments overwriting them.
+	Overwrites []token.Pos
+}
+
+// diagnostic reports the field for the converter fnName, pointing at each assignment.
+func (o OverwrittenField) diagnostic(fnName string) analysis.Diagnostic {
+	var related []analysis.RelatedInformation
+	for _, pos := range o.Lost {
+		related = append(related, analysis.RelatedInformation{Pos: pos, Message: o.Field + " set here, never used"})
+	}
+	for _, pos := range o.Overwrites {
+		related = append(related, analysis.RelatedInformation{Pos: pos, Message: o.Field + " set again here"})
+	}
+	slices.SortStableFunc(related, func(a, b analysis.RelatedInformation) int { return cmp.Compare(a.Pos, b.Pos) })
+	return analysis.Diagnostic{
+		Pos: o.Lost[0],
+		Message: fmt.Sprintf("%s: output field %s assigned more than once on the same path, the first value is never used",
+			fnName, o.Field),
+		Related: related,
+	}
+}
+
+// pendingWrites holds, per output field, the assignments whose value may still be used:
+// neither read nor overwritten yet on some path.
+type pendingWrites map[string]map[token.Pos]bool
+
+// overwrittenFields returns the fields of outVar (a struct or pointer to one) that fn
+// assigns while a value it assigned before is still pending on every path: a dead store
+// into the output. Assignments are the keys of a literal assigned to outVar and
+// out.Field = value; any other use of a field (a read, out.Field.Sub = v, out.Field += v)
+// uses its pending values, and any other use of outVar (a call, a return, a closure)
+// all of them, as does leaving the function. A local alias sharing the output (p := &out,
+// addr := &out.Address) stands for it: p.Field = value assigns, p.Field and addr.City use
+// Field and Address. A value overwritten on one path and used
+// on another, like a default replaced under a condition, is not reported.
+func overwrittenFields(
+	info *types.Info,
+	fn *ast.FuncDecl,
+	outVar string,
+	outStruct *types.Struct,
+	candidateName, prefix string,
+) []OverwrittenField {
+	if fn.Body == nil || outVar == "" {
+		return nil
+	}
+	output := resolveVar(info, fn.Body, outVar)
+	g := cfg.New(fn.Body, func(call *ast.CallExpr) bool {
+		id, ok := call.Fun.(*ast.Ident)
+		return !ok || id.Name != "panic"
+	})
+	preds := make(map[*cfg.Block][]*cfg.Block)
+	for _, b := range g.Blocks {
+		for _, s := range b.Succs {
+			preds[s] = append(preds[s], b)
+		}
+	}
+
+	fields := make(map[token.Pos]string)
+	used := make(map[token.Pos]bool)
+	overwrittenBy := make(map[token.Pos]map[token.Pos]bool)
+	useAll := func(state pendingWrites) {
+		for field := range state {
+			for pos := range state[field] {
+				used[pos] = true
+			}
+			delete(state, field)
+		}
+	}
+	write := func(state pendingWrites, field string, pos token.Pos) {
+		fields[pos] = field
+		for prev := range state[field] {
+			if prev == pos {
+				continue
+			}
+			if overwrittenBy[prev] == nil {
+				overwrittenBy[prev] = make(map[token.Pos]bool)
+			}
+			overwrittenBy[prev][pos] = true
+		}
+		state[field] = map[token.Pos]bool{pos: true}
+	}
+	transfer := func(state pendingWrites, n ast.Node) {
+		targets, lit := outputWrites(n, output, outStruct, candidateName)
+		declared := aliasDeclarations(n, output)
+		readAll := false
+		inspectReads(n, func(node ast.Node) bool {
+			switch x := node.(type) {
+			case *ast.SelectorExpr:
+				if targets[x] != "" {
+					return false
+				}
+				field := ""
+				if output.ref(x.X) {
+					field = x.Sel.Name
+				} else if chain, isAlias := sharedAlias(output, x.X); isAlias {
+					field, _, _ = strings.Cut(joinPath(chain, x.Sel.Name), ".")
+				}
+				if _, isField := structField(outStruct, field); isField {
+					for pos := range state[field] {
+						used[pos] = true
+					}
+					delete(state, field)
+					return false
+				}
+			case *ast.Ident:
+				if lit != nil && x == lit.target || declared[x] {
+					return true
+				}
+				if output.is(x) {
+					readAll = true
+				} else if chain, isAlias := sharedAlias(output, x); isAlias && chain == "" {
+					readAll = true
+				} else if isAlias {
+					field, _, _ := strings.Cut(chain, ".")
+					for pos := range state[field] {
+						used[pos] = true
+					}
+					delete(state, field)
+				}
+			}
+			return true
+		})
+		if readAll {
+			useAll(state)
+		}
+		if lit != nil {
+			// A new literal sets its own keys and zeroes the others: the values pending for
+			// those are not reported (they are not overwritten by another assignment).
+			for field := range state {
+				if _, isKey := lit.keys[field]; !isKey {
+					for pos := range state[field] {
+						used[pos] = true
+					}
+					delete(state, field)
+				}
+			}
+			for field, pos := range lit.keys {
+				write(state, field, pos)
+			}
+		}
+		for sel, field := range targets {
+			write(state, field, sel.Pos())
+		}
+		if _, isReturn := n.(*ast.ReturnStmt); isReturn {
+			useAll(state)
+		}
+	}
+
+	out := make(map[*cfg.Block]pendingWrites)
+	for changed := true; changed; {
+		changed = false
+		for _, b := range g.Blocks {
+			if !b.Live {
+				continue
+			}
+			var state pendingWrites
+			if b == g.Blocks[0] {
+				state = make(pendingWrites)
+			}
+			for _, p := range preds[b] {
+				if pout, ok := out[p]; ok {
+					state = mergePending(state, pout)
+				}
+			}
+			if state == nil {
+				continue
+			}
+			for _, n := range b.Nodes {
+				transfer(state, n)
+			}
+			if len(b.Succs) == 0 {
+				useAll(state)
+			}
+			if prev, ok := out[b]; !ok || !maps.EqualFunc(prev, state, maps.Equal) {
+				out[b] = state
+				changed = true
+			}
+		}
+	}
+
+	byField := make(map[string]*OverwrittenField)
+	var res []*OverwrittenField
+	for _, pos := range slices.Sorted(maps.Keys(overwrittenBy)) {
+		if used[pos] {
+			continue
+		}
+		field := fields[pos]
+		o := byField[field]
+		if o == nil {
+			o = &OverwrittenField{Field: joinPath(prefix, field)}
+			byField[field] = o
+			res = append(res, o)
+		}
+		o.Lost = append(o.Lost, pos)
+		for next := range overwrittenBy[pos] {
+			if !slices.Contains(o.Overwrites, next) {
+				o.Overwrites = append(o.Overwrites, next)
+			}
+		}
+	}
+	overwritten := make([]OverwrittenField, len(res))
+	for i, o := range res {
+		slices.Sort(o.Overwrites)
+		overwritten[i] = *o
+	}
+	return overwritten
+}
+
+// literalWrite is an output literal assigned to the output variable (out := UserDTO{...},
+// *dst = UserDTO{...}): target is the variable on the left, keys the position of each key.
+type literalWrite struct {
+	target *ast.Ident
+	keys   map[string]token.Pos
+}
+
+// outputWrites returns the assignments a CFG node makes to fields of output, directly or
+// through an alias of it (see sharedAlias): the selectors assigned (out.Status = ...)
+// with their field, and an output literal assigned to output whole.
+func outputWrites(
+	n ast.Node,
+	output varTarget,
+	outStruct *types.Struct,
+	candidateName string,
+) (map[*ast.SelectorExpr]string, *literalWrite) {
+	var lhs, rhs []ast.Expr
+	switch x := n.(type) {
+	case *ast.AssignStmt:
+		if x.Tok != token.ASSIGN && x.Tok != token.DEFINE {
+			return nil, nil
+		}
+		lhs, rhs = x.Lhs, x.Rhs
+	case *ast.ValueSpec:
+		for _, name := range x.Names {
+			lhs = append(lhs, name)
+		}
+		rhs = x.Values
+	default:
+		return nil, nil
+	}
+	if len(lhs) != len(rhs) {
+		return nil, nil
+	}
+	targets := make(map[*ast.SelectorExpr]string)
+	var lit *literalWrite
+	for i, l := range lhs {
+		if sel, ok := l.(*ast.SelectorExpr); ok {
+			if chain, isAlias := sharedAlias(output, sel.X); output.ref(sel.X) || isAlias && chain == "" {
+				if _, isField := structField(outStruct, sel.Sel.Name); isField {
+					targets[sel] = sel.Sel.Name
+				}
+				continue
+			}
+		}
+		cl := compositeLitOf(rhs[i], candidateName)
+		if chain, isAlias := sharedAlias(output, l); cl == nil || !output.ref(l) && (!isAlias || chain != "") {
+			continue
+		}
+		lit = &literalWrite{target: baseIdent(l), keys: make(map[string]token.Pos)}
+		for _, elt := range cl.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				if key, isIdent := kv.Key.(*ast.Ident); isIdent {
+					lit.keys[key.Name] = kv.Pos()
+				}
+			}
+		}
+	}
+	return targets, lit
+}
+
+// sharedAlias returns the field chain a local alias of output stands for, when expr, seen
+// through &, * and parentheses, is one sharing the output's value (p := &out for "",
+// addr := &out.Address for "Address"). Writes through a copy land elsewhere, and its
+// reads are not of the output.
+func sharedAlias(output varTarget, expr ast.Expr) (string, bool) {
+	id := baseIdent(expr)
+	if id == nil {
+		return "", false
+	}
+	a, ok := output.aliasOf(id)
+	if !ok || !a.shared {
+		return "", false
+	}
+	return a.chain, true
+}
+
+// aliasDeclarations returns the identifiers of a CFG node declaring an alias sharing
+// output whole (p := &out): the alias and output there. Taking the address for an alias
+// followed field by field uses nothing yet.
+func aliasDeclarations(n ast.Node, output varTarget) map[*ast.Ident]bool {
+	var lhs, rhs []ast.Expr
+	switch x := n.(type) {
+	case *ast.AssignStmt:
+		if x.Tok != token.DEFINE {
+			return nil
+		}
+		lhs, rhs = x.Lhs, x.Rhs
+	case *ast.ValueSpec:
+		for _, name := range x.Names {
+			lhs = append(lhs, name)
+		}
+		rhs = x.Values
+	}
+	if len(lhs) != len(rhs) {
+		return nil
+	}
+	declared := make(map[*ast.Ident]bool)
+	for i, l := range lhs {
+		id, ok := l.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		if chain, isAlias := sharedAlias(output, id); isAlias && chain == "" && output.ref(rhs[i]) {
+			declared[id] = true
+			declared[baseIdent(rhs[i])] = true
+		}
+	}
+	return declared
+}
+
+// inspectReads inspects the parts of a CFG node evaluated at that point: a range
+// statement stands for the assignment of its key and value only, its body having
+// blocks of its own.
+func inspectReads(n ast.Node, f func(ast.Node) bool) {
+	if rs, ok := n.(*ast.RangeStmt); ok {
+		for _, e := range []ast.Expr{rs.Key, rs.Value} {
+			if e != nil {
+				ast.Inspect(e, f)
+			}
+		}
+		return
+	}
+	ast.Inspect(n, f)
+}
+
+// baseIdent returns the identifier under &, * and parentheses.
+func baseIdent(expr ast.Expr) *ast.Ident {
+	for {
+		switch x := expr.(type) {
+		case *ast.Ident:
+			return x
+		case *ast.UnaryExpr:
+			expr = x.X
+		case *ast.StarExpr:
+			expr = x.X
+		case *ast.ParenExpr:
+			expr = x.X
+		default:
+			return nil
+		}
+	}
+}
+
+// mergePending returns the union of two states, the first one (nil for none yet) reused.
+func mergePending(state, other pendingWrites) pendingWrites {
+	if state == nil {
+		state = make(pendingWrites)
+	}
+	for field, writes := range other {
+		if state[field] == nil {
+			state[field] = make(map[token.Pos]bool)
+		}
+		maps.Copy(state[field], writes)
+	}
+	return state
+}
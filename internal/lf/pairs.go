@@ -0,0 +1,107 @@
+package lf
+
+import (
+	"go/types"
+	"path"
+	"strings"
+
+	"github.com/amberpixels/lostfield/internal/config"
+)
+
+// matchesTypePair reports whether in -> out is listed in the type-pairs setting, which
+// makes the pair a converter whatever its type names.
+func matchesTypePair(in, out candidate, cfg *config.Config) bool {
+	for _, pair := range cfg.TypePairs {
+		inPattern, outPattern, err := config.SplitTypePair(pair)
+		if err != nil {
+			continue
+		}
+		if typeMatchesPattern(in.fullType, inPattern) && typeMatchesPattern(out.fullType, outPattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// typeMatchesPattern reports whether t is the named type a type-pairs side refers to.
+// The part after the last dot is the type name; the part before it, when present, is
+// matched against the package (see packageMatchesPattern) and, failing that, the
+// package name, so both "github.com/acme/shop/domain.Account" and "domain.Account" work.
+func typeMatchesPattern(t types.Type, pattern string) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Origin().Obj()
+
+	pkgPattern, namePattern := "", pattern
+	if i := strings.LastIndex(pattern, "."); i >= 0 {
+		pkgPattern, namePattern = pattern[:i], pattern[i+1:]
+	}
+	if matched, _ := path.Match(namePattern, obj.Name()); !matched {
+		return false
+	}
+	if pkgPattern == "" {
+		return true
+	}
+	if obj.Pkg() == nil {
+		return false
+	}
+	if packageMatchesPattern(obj.Pkg().Path(), pkgPattern) {
+		return true
+	}
+	matched, _ := path.Match(pkgPattern, obj.Pkg().Name())
+	return matched
+}
+
+// matchesPackagePairs reports whether in -> out comes from a pair of packages listed in
+// the package-pairs setting. An empty setting restricts nothing.
+func matchesPackagePairs(in, out candidate, cfg *config.Config) bool {
+	if len(cfg.PackagePairs) == 0 {
+		return true
+	}
+	inPkg, outPkg := candidatePkgPath(in), candidatePkgPath(out)
+	if inPkg == "" || outPkg == "" {
+		return false
+	}
+	for _, pair := range cfg.PackagePairs {
+		inPattern, outPattern, err := config.SplitPackagePair(pair)
+		if err != nil {
+			continue
+		}
+		if packageMatchesPattern(inPkg, inPattern) && packageMatchesPattern(outPkg, outPattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// candidatePkgPath returns the import path of the package declaring the candidate type
+// (the core type, for a type parameter), or "" when it has none.
+func candidatePkgPath(c candidate) string {
+	t := c.fullType
+	if tp, ok := t.(*types.TypeParam); ok {
+		t = coreType(tp)
+	}
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return ""
+	}
+	return named.Obj().Pkg().Path()
+}
+
+// packageMatchesPattern reports whether the glob pattern matches the import path or any
+// of its trailing elements, so "*/domain" and "shop/domain" both match
+// "github.com/acme/shop/domain". Like path.Match, * does not cross a /.
+func packageMatchesPattern(pkgPath, pattern string) bool {
+	for {
+		if matched, _ := path.Match(pattern, pkgPath); matched {
+			return true
+		}
+		_, rest, ok := strings.Cut(pkgPath, "/")
+		if !ok {
+			return false
+		}
+		pkgPath = rest
+	}
+}
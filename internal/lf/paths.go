@@ -0,0 +1,400 @@
+package lf
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"maps"
+
+	"golang.org/x/tools/go/cfg"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// returnPath is one return statement of a converter and the output fields set on every
+// path reaching it.
+type returnPath struct {
+	ret    *ast.ReturnStmt
+	fields UsageLookup
+}
+
+// outputReturnPaths returns, for every return statement of fn that yields a non-zero
+// value for the result at resultPos, the output fields set on every path to it.
+//
+// The body's control-flow graph is walked with a must-analysis: a field counts on a path
+// only when it is assigned on all paths reaching the return (fields of outVar written
+// through selectors, or keys of an output literal assigned to outVar). A return of a
+// literal counts that literal's keys, a return of outVar (or a bare return of the named
+// result) the fields flowing into it.
+//
+// Returns that yield no output are left out: zero literals (return UserDTO{}, err), nil,
+// and error paths returning an error known to be non-nil (see isErrorReturn).
+// Returns of anything else (calls, other variables) are not checked.
+func outputReturnPaths(
+	fn *ast.FuncDecl,
+	sig *types.Signature,
+	outVar, candidateName string,
+	resultPos int,
+	helpers *helperSummaries,
+) []returnPath {
+	if fn.Body == nil {
+		return nil
+	}
+	if outVar == "" {
+		outVar = findLocalCandidateVariable(fn, candidateName)
+	}
+	// The output and the error are resolved over the whole body once: followed one
+	// statement at a time, a variable shadowing them in a nested block would pass for them.
+	info := helpers.pass.TypesInfo
+	output := resolveVar(info, fn.Body, outVar)
+	errPos, errName := errorResult(fn, sig)
+	errVar := resolveVar(info, fn.Body, errName)
+
+	g := cfg.New(fn.Body, func(call *ast.CallExpr) bool {
+		id, ok := call.Fun.(*ast.Ident)
+		return !ok || id.Name != "panic"
+	})
+
+	preds := make(map[*cfg.Block][]*cfg.Block)
+	for _, b := range g.Blocks {
+		for _, s := range b.Succs {
+			preds[s] = append(preds[s], b)
+		}
+	}
+
+	// out[b] holds the fields set on every path through the end of b; a block not yet
+	// reached has no entry, standing for "every field" in the intersection.
+	out := make(map[*cfg.Block]UsageLookup)
+	for changed := true; changed; {
+		changed = false
+		for _, b := range g.Blocks {
+			if !b.Live {
+				continue
+			}
+			state := blockEntryState(b, g, preds[b], out)
+			if state == nil {
+				continue
+			}
+			for _, n := range b.Nodes {
+				state = transferOutputFields(state, n, output, candidateName, helpers)
+			}
+			if prev, ok := out[b]; !ok || !maps.Equal(prev, state) {
+				out[b] = state
+				changed = true
+			}
+		}
+	}
+
+	var res []returnPath
+	for _, b := range g.Blocks {
+		ret := b.Return()
+		if !b.Live || ret == nil {
+			continue
+		}
+		if helpers.isErrorReturn(fn.Body, ret, b, errPos, errVar) {
+			continue
+		}
+		state := blockEntryState(b, g, preds[b], out)
+		if state == nil {
+			continue
+		}
+		for _, n := range b.Nodes[:len(b.Nodes)-1] {
+			state = transferOutputFields(state, n, output, candidateName, helpers)
+		}
+
+		var fields UsageLookup
+		switch {
+		case len(ret.Results) == 0:
+			if outVar == "" || outVar != resultName(fn, resultPos) {
+				continue
+			}
+			fields = state
+		case resultPos < len(ret.Results):
+			expr := ret.Results[resultPos]
+			if len(ret.Results) != sig.Results().Len() {
+				continue // return f(): a call yielding every result
+			}
+			if isNilIdent(expr) {
+				continue
+			}
+			if cl := outputCompositeLitOf(expr, candidateName); cl != nil {
+				if len(cl.Elts) == 0 {
+					continue
+				}
+				fields = make(UsageLookup)
+				extractKeysFromCompositeLit(cl, fields)
+			} else if id, ok := unwrapBase(expr).(*ast.Ident); ok && output.is(id) {
+				fields = state
+			} else {
+				continue
+			}
+		default:
+			continue
+		}
+		res = append(res, returnPath{ret: ret, fields: fields})
+	}
+	return res
+}
+
+// blockEntryState returns the fields set on every path into b: none at the entry block,
+// otherwise the intersection over its reached predecessors, or nil when none is reached yet.
+func blockEntryState(b *cfg.Block, g *cfg.CFG, preds []*cfg.Block, out map[*cfg.Block]UsageLookup) UsageLookup {
+	if b == g.Blocks[0] {
+		return make(UsageLookup)
+	}
+	var state UsageLookup
+	for _, p := range preds {
+		pout, ok := out[p]
+		if !ok {
+			continue
+		}
+		if state == nil {
+			state = maps.Clone(pout)
+			continue
+		}
+		maps.DeleteFunc(state, func(k string, _ struct{}) bool { return !pout.Has(k) })
+	}
+	return state
+}
+
+// transferOutputFields applies one CFG node to the set of output fields: assigning an
+// output literal to output replaces the set with the literal's keys, and every field of
+// output the node touches, sets through a setter or hands to a helper is added.
+func transferOutputFields(
+	state UsageLookup,
+	n ast.Node,
+	output varTarget,
+	candidateName string,
+	helpers *helperSummaries,
+) UsageLookup {
+	if output.name == "" {
+		return state
+	}
+	state = maps.Clone(state)
+	if assign, ok := n.(*ast.AssignStmt); ok && len(assign.Lhs) == len(assign.Rhs) {
+		for i, lhs := range assign.Lhs {
+			if id, isIdent := lhs.(*ast.Ident); isIdent && output.is(id) {
+				if cl := compositeLitOf(assign.Rhs[i], candidateName); cl != nil {
+					state = make(UsageLookup)
+					extractKeysFromCompositeLit(cl, state)
+				}
+			}
+		}
+	}
+	for k := range helpers.collectTarget(n, output).written() {
+		state.Add(k)
+	}
+	return state
+}
+
+// errorResult returns the position of fn's trailing error result and its name ("" when
+// unnamed), or -1 when fn does not return an error last.
+func errorResult(fn *ast.FuncDecl, sig *types.Signature) (int, string) {
+	results := sig.Results()
+	if results.Len() == 0 {
+		return -1, ""
+	}
+	last := results.At(results.Len() - 1)
+	if !types.Identical(last.Type(), types.Universe.Lookup("error").Type()) {
+		return -1, ""
+	}
+	return results.Len() - 1, last.Name()
+}
+
+// isErrorReturn reports whether ret, in block b of body, leaves through the error path:
+// the error it returns is known to be non-nil (see isNonNilError). For a bare return,
+// that is the named error result errVar, known to be non-nil from the enclosing
+// "if err != nil" or from its last assignment in b (err = fmt.Errorf(...)). An error
+// that may be nil, like return out, err after a call, does not make an error path.
+func (h *helperSummaries) isErrorReturn(
+	body *ast.BlockStmt,
+	ret *ast.ReturnStmt,
+	b *cfg.Block,
+	errPos int,
+	errVar varTarget,
+) bool {
+	if errPos < 0 {
+		return false
+	}
+	if len(ret.Results) > 0 {
+		return errPos < len(ret.Results) && h.isNonNilError(body, ret, ret.Results[errPos])
+	}
+	if errVar.name == "" || errVar.name == "_" {
+		return false
+	}
+	for i := len(b.Nodes) - 1; i >= 0; i-- {
+		if assign, ok := b.Nodes[i].(*ast.AssignStmt); ok && len(assign.Lhs) == len(assign.Rhs) {
+			for j, lhs := range assign.Lhs {
+				if errVar.ref(lhs) {
+					return h.isNonNilError(body, ret, assign.Rhs[j])
+				}
+			}
+		}
+	}
+	for _, ifStmt := range enclosingIfs(body, ret) {
+		if errVar.usedIn(ifStmt.cond) && checksNonNil(ifStmt.cond, ifStmt.then, errVar.ref) {
+			return true
+		}
+	}
+	return false
+}
+
+// isNonNilError reports whether err, evaluated at ret in body, is known to be a non-nil
+// error: a call to errors.New or fmt.Errorf, an error literal (&NotFoundError{...}), a
+// sentinel error of the package (var ErrNotFound = errors.New(...)), or a value the
+// enclosing if statements check against nil (if err != nil { return ..., err }).
+func (h *helperSummaries) isNonNilError(body *ast.BlockStmt, ret *ast.ReturnStmt, err ast.Expr) bool {
+	info := h.pass.TypesInfo
+	switch x := ast.Unparen(err).(type) {
+	case *ast.CallExpr:
+		return isNewError(info, x)
+	case *ast.CompositeLit:
+		return true
+	case *ast.UnaryExpr:
+		_, isLit := ast.Unparen(x.X).(*ast.CompositeLit)
+		return x.Op == token.AND && isLit
+	case *ast.Ident:
+		obj := info.ObjectOf(x)
+		if obj == nil {
+			return false
+		}
+		if h.isSentinelError(obj) {
+			return true
+		}
+		same := func(e ast.Expr) bool {
+			id, ok := ast.Unparen(e).(*ast.Ident)
+			return ok && info.ObjectOf(id) == obj
+		}
+		for _, ifStmt := range enclosingIfs(body, ret) {
+			if checksNonNil(ifStmt.cond, ifStmt.then, same) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isNewError reports whether call creates an error: errors.New or fmt.Errorf, which never
+// return nil.
+func isNewError(info *types.Info, call *ast.CallExpr) bool {
+	fn := typeutil.StaticCallee(info, call)
+	if fn == nil || fn.Pkg() == nil {
+		return false
+	}
+	name := fn.Pkg().Path() + "." + fn.Name()
+	return name == "errors.New" || name == "fmt.Errorf"
+}
+
+// isSentinelError reports whether obj is a package-level variable of this package
+// initialized with a new error: var errNoID = errors.New("no id").
+func (h *helperSummaries) isSentinelError(obj types.Object) bool {
+	if obj.Pkg() != h.pass.Pkg || obj.Parent() != h.pass.Pkg.Scope() {
+		return false
+	}
+	for _, file := range h.pass.Files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vs := spec.(*ast.ValueSpec)
+				for i, name := range vs.Names {
+					if h.pass.TypesInfo.Defs[name] != obj || len(vs.Values) != len(vs.Names) {
+						continue
+					}
+					call, isCall := ast.Unparen(vs.Values[i]).(*ast.CallExpr)
+					return isCall && isNewError(h.pass.TypesInfo, call)
+				}
+			}
+		}
+	}
+	return false
+}
+
+// enclosingIf is an if statement around a node: its condition, and whether the node
+// sits in its then branch rather than its else branch.
+type enclosingIf struct {
+	cond ast.Expr
+	then bool
+}
+
+// enclosingIfs returns the if statements of body whose branches hold n.
+func enclosingIfs(body *ast.BlockStmt, n ast.Node) []enclosingIf {
+	var res []enclosingIf
+	ast.Inspect(body, func(node ast.Node) bool {
+		if node == nil || node.Pos() > n.Pos() || node.End() < n.End() {
+			return false
+		}
+		if ifStmt, ok := node.(*ast.IfStmt); ok {
+			switch {
+			case ifStmt.Body.Pos() <= n.Pos() && n.End() <= ifStmt.Body.End():
+				res = append(res, enclosingIf{cond: ifStmt.Cond, then: true})
+			case ifStmt.Else != nil && ifStmt.Else.Pos() <= n.Pos() && n.End() <= ifStmt.Else.End():
+				res = append(res, enclosingIf{cond: ifStmt.Cond, then: false})
+			}
+		}
+		return true
+	})
+	return res
+}
+
+// checksNonNil reports whether cond, holding (then) or not, guarantees that an
+// expression matched by is is not nil: is(x) != nil, alone or among conditions joined by
+// && in the then branch; is(x) == nil in the else branch.
+func checksNonNil(cond ast.Expr, then bool, is func(ast.Expr) bool) bool {
+	bin, ok := ast.Unparen(cond).(*ast.BinaryExpr)
+	if !ok {
+		return false
+	}
+	switch {
+	case bin.Op == token.LAND && then:
+		return checksNonNil(bin.X, then, is) || checksNonNil(bin.Y, then, is)
+	case bin.Op == token.LOR && !then:
+		return checksNonNil(bin.X, then, is) || checksNonNil(bin.Y, then, is)
+	case bin.Op == token.NEQ && then, bin.Op == token.EQL && !then:
+		return is(bin.X) && isNilIdent(bin.Y) || isNilIdent(bin.X) && is(bin.Y)
+	}
+	return false
+}
+
+// isNilIdent reports whether expr is the predeclared nil.
+func isNilIdent(expr ast.Expr) bool {
+	id, ok := ast.Unparen(expr).(*ast.Ident)
+	return ok && id.Name == "nil"
+}
+
+// resultName returns the declared name of the result at pos, or "".
+func resultName(fn *ast.FuncDecl, pos int) string {
+	if fn.Type.Results == nil {
+		return ""
+	}
+	i := 0
+	for _, field := range fn.Type.Results.List {
+		if len(field.Names) == 0 {
+			if i == pos {
+				return ""
+			}
+			i++
+			continue
+		}
+		for _, name := range field.Names {
+			if i == pos {
+				return name.Name
+			}
+			i++
+		}
+	}
+	return ""
+}
+
+// resultPositions returns the position in the signature of every candidate result, in
+// the order findCandidateParams lists them.
+func resultPositions(sig *types.Signature) []int {
+	var res []int
+	for i := range sig.Results().Len() {
+		if _, ok := extractCandidateType(sig.Results().At(i).Type()); ok {
+			res = append(res, i)
+		}
+	}
+	return res
+}
@@ -0,0 +1,128 @@
+package lf
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/amberpixels/lostfield/internal/config"
+)
+
+// CollectSetterFields walks n and returns the fields written on varName through setter
+// methods: for each call whose name matches one of the setter patterns, the part the
+// "*" stands for (out.SetName(v) under "Set*" records Name). Calls chained off varName
+// count too (b.SetName(x).SetEmail(y)). Each name is also recorded with a lowercase
+// first letter, for the unexported fields behind a builder's methods (b.Name(x) sets name).
+func CollectSetterFields(info *types.Info, n ast.Node, varName string, patterns []string) UsageLookup {
+	return collectSetters(n, resolveVar(info, n, varName), patterns)
+}
+
+// collectSetters is CollectSetterFields for target, already resolved.
+func collectSetters(n ast.Node, target varTarget, patterns []string) UsageLookup {
+	used := make(UsageLookup)
+	if target.name == "" || len(patterns) == 0 {
+		return used
+	}
+	ast.Inspect(n, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !isCallChainOn(sel.X, target) {
+			return true
+		}
+		for _, p := range patterns {
+			if field := setterField(sel.Sel.Name, p); field != "" {
+				used.Add(field)
+				used.Add(lowerFirst(field))
+			}
+		}
+		return true
+	})
+	return used
+}
+
+// isCallChainOn reports whether expr is the target variable or a method call chained off
+// it, like the receiver of .SetEmail in b.SetName(x).SetEmail(y).
+func isCallChainOn(expr ast.Expr, target varTarget) bool {
+	for {
+		switch x := unwrapBase(expr).(type) {
+		case *ast.Ident:
+			return target.is(x)
+		case *ast.CallExpr:
+			sel, ok := x.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return false
+			}
+			expr = sel.X
+		default:
+			return false
+		}
+	}
+}
+
+// setterField returns the field name the setter pattern's "*" matches in method, or ""
+// when the pattern does not match. The field name must start with an upper-case
+// letter, so "Set*" takes SetName but not Settle.
+func setterField(method, pattern string) string {
+	prefix, suffix, err := config.SplitSetterPattern(pattern)
+	if err != nil || len(method) <= len(prefix)+len(suffix) ||
+		!strings.HasPrefix(method, prefix) || !strings.HasSuffix(method, suffix) {
+		return ""
+	}
+	field := method[len(prefix) : len(method)-len(suffix)]
+	if r, _ := utf8.DecodeRuneInString(field); !unicode.IsUpper(r) {
+		return ""
+	}
+	return field
+}
+
+// lowerFirst returns s with its first letter lower-cased.
+func lowerFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToLower(r)) + s[size:]
+}
+
+// outputSetters maps each field of the named output type that has a one-argument setter
+// to that setter's name ("Name" -> "SetName"), for the smart fixer. Patterns are tried
+// in order; fields without a setter are left out, and so is everything when t is not
+// a named type.
+func outputSetters(t types.Type, patterns []string) map[string]string {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return nil
+	}
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return nil
+	}
+	setters := make(map[string]string)
+	for field := range st.Fields() {
+		name := field.Name()
+		if !field.Exported() {
+			// A builder's unexported name field is set by its exported Name method.
+			r, size := utf8.DecodeRuneInString(name)
+			name = string(unicode.ToUpper(r)) + name[size:]
+		}
+		for _, p := range patterns {
+			prefix, suffix, err := config.SplitSetterPattern(p)
+			if err != nil {
+				continue
+			}
+			method := prefix + name + suffix
+			obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(named), true, named.Obj().Pkg(), method)
+			fn, ok := obj.(*types.Func)
+			if !ok {
+				continue
+			}
+			if sig, ok := fn.Type().(*types.Signature); ok && sig.Params().Len() == 1 {
+				setters[field.Name()] = method
+				break
+			}
+		}
+	}
+	return setters
+}
@@ -0,0 +1,250 @@
+package lf
+
+import (
+	"go/ast"
+	"go/types"
+	"maps"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/buildssa"
+	"golang.org/x/tools/go/ssa"
+	"golang.org/x/tools/go/types/typeutil"
+
+	"github.com/amberpixels/lostfield/internal/config"
+)
+
+// maxHelperDepth bounds how deep helper calls are followed: a converter's helper, its
+// helpers, and so on.
+const maxHelperDepth = 4
+
+// helperSummaries credits a converter with the fields its helpers map. Converters often
+// split the work: fillAddress(&out, in), out.Meta = buildMeta(in). Each function of
+// the package is summarized once per pass - the fields and methods it uses on each
+// parameter, directly or through its own helpers - and a caller handing a variable to it
+// whole gets that usage merged into its own.
+type helperSummaries struct {
+	pass  *analysis.Pass
+	cfg   *config.Config
+	decls map[*types.Func]*ast.FuncDecl
+	memo  map[*types.Func]*funcSummary
+	// active holds the functions being summarized, so recursion ends at a cycle.
+	active map[*types.Func]bool
+	// results memoizes the validation of the package converters, which nested converter
+	// calls need too (see calleeProblem); validating holds those in progress, for the
+	// same reason as active.
+	results    map[*ast.FuncDecl]*ConverterValidationResult
+	validating map[*ast.FuncDecl]bool
+	// ssa is the SSA form of the package in dataflow mode (nil otherwise), and ssaFuncs
+	// its functions by body, indexed on first use (see valueFlow).
+	ssa      *buildssa.SSA
+	ssaFuncs map[*ast.BlockStmt]*ssa.Function
+}
+
+// funcSummary records how a function uses its receiver and each of its parameters.
+type funcSummary struct {
+	recv   varUsage
+	params []varUsage
+}
+
+// varUsage is the fields and methods used on a variable, and the fields written on it
+// through setter methods. Setter writes only count for an output (see written): on an
+// input, in.SetName(v) writes Name rather than reading it.
+type varUsage struct {
+	fields  UsageLookup
+	methods UsageLookup
+	setters UsageLookup
+}
+
+// written returns the fields set on an output variable: those used and those written
+// through setters.
+func (u varUsage) written() UsageLookup {
+	written := maps.Clone(u.fields)
+	maps.Copy(written, u.setters)
+	return written
+}
+
+func newHelperSummaries(pass *analysis.Pass, cfg *config.Config) *helperSummaries {
+	h := &helperSummaries{
+		pass:   pass,
+		cfg:    cfg,
+		decls:  make(map[*types.Func]*ast.FuncDecl),
+		memo:   make(map[*types.Func]*funcSummary),
+		active: make(map[*types.Func]bool),
+
+		results:    make(map[*ast.FuncDecl]*ConverterValidationResult),
+		validating: make(map[*ast.FuncDecl]bool),
+	}
+	if cfg.Dataflow {
+		h.ssa, _ = pass.ResultOf[buildssa.Analyzer].(*buildssa.SSA)
+	}
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			if obj, ok := pass.TypesInfo.Defs[fn.Name].(*types.Func); ok {
+				h.decls[obj] = fn
+			}
+		}
+	}
+	return h
+}
+
+// collect returns the usage of varName in n: its selectors, setter calls and method
+// calls, and those of the helpers n hands varName to.
+func (h *helperSummaries) collect(n ast.Node, varName string) varUsage {
+	return h.collectTarget(n, resolveVar(h.pass.TypesInfo, n, varName))
+}
+
+// collectTarget is collect for a variable already resolved, like the output of a
+// converter followed one statement at a time.
+func (h *helperSummaries) collectTarget(n ast.Node, target varTarget) varUsage {
+	usage, _ := h.usage(n, target, 0)
+	return usage
+}
+
+// usage collects the usage of target in n at the given helper depth. It reports false
+// when a summary below was cut short by the depth bound or a cycle, so that incomplete
+// results are not memoized.
+func (h *helperSummaries) usage(n ast.Node, target varTarget, depth int) (varUsage, bool) {
+	usage := varUsage{
+		fields:  collectUsage(n, target, RecordFields),
+		methods: collectUsage(n, target, RecordMethods),
+		setters: collectSetters(n, target, h.cfg.SetterPatterns),
+	}
+
+	complete := true
+	forwards := make(map[*ast.CallExpr]bool)
+	ast.Inspect(n, func(n ast.Node) bool {
+		if ret, ok := n.(*ast.ReturnStmt); ok {
+			// return convert(in) forwards rather than helps: the callee builds the whole
+			// output and the forwarding rules judge that shape.
+			for _, res := range ret.Results {
+				if call, isCall := ast.Unparen(res).(*ast.CallExpr); isCall {
+					forwards[call] = true
+				}
+			}
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok || forwards[call] {
+			return true
+		}
+		callee := typeutil.StaticCallee(h.pass.TypesInfo, call)
+		if callee == nil {
+			return true
+		}
+		passed := calleeUsages(call, target)
+		if len(passed) == 0 {
+			return true
+		}
+		summary, ok := h.summary(callee.Origin(), depth+1)
+		complete = complete && ok
+		if summary == nil {
+			return true
+		}
+		for _, idx := range passed {
+			var helper varUsage
+			switch {
+			case idx < 0:
+				helper = summary.recv
+			case len(summary.params) == 0:
+				continue
+			default:
+				helper = summary.params[min(idx, len(summary.params)-1)] // variadic
+			}
+			maps.Copy(usage.fields, helper.fields)
+			maps.Copy(usage.methods, helper.methods)
+			maps.Copy(usage.setters, helper.setters)
+		}
+		return true
+	})
+	return usage, complete
+}
+
+// calleeUsages returns the positions at which call hands the target variable on whole:
+// -1 for the receiver of a method call (v.helper()), and the index of each argument that
+// is v, &v or *v.
+func calleeUsages(call *ast.CallExpr, target varTarget) []int {
+	var res []int
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok && target.ref(sel.X) {
+		res = append(res, -1)
+	}
+	for i, arg := range call.Args {
+		if target.ref(arg) {
+			res = append(res, i)
+		}
+	}
+	return res
+}
+
+// summary returns the summary of a package function, or of a function from another
+// package that exported a FieldUsageFact. It is nil when there is neither, or when fn is
+// beyond the depth bound or already being summarized (recursion). The boolean reports
+// whether the summary is complete.
+func (h *helperSummaries) summary(fn *types.Func, depth int) (*funcSummary, bool) {
+	if s, ok := h.memo[fn]; ok {
+		return s, true
+	}
+	decl, ok := h.decls[fn]
+	if !ok {
+		s := h.importedSummary(fn)
+		if s != nil {
+			h.memo[fn] = s
+		}
+		return s, true
+	}
+	if depth > maxHelperDepth || h.active[fn] {
+		return nil, false
+	}
+	h.active[fn] = true
+	defer delete(h.active, fn)
+
+	s := &funcSummary{}
+	complete := true
+	if decl.Recv != nil && len(decl.Recv.List) > 0 && len(decl.Recv.List[0].Names) > 0 {
+		var ok bool
+		s.recv, ok = h.paramUsage(decl, decl.Recv.List[0].Names[0].Name, depth)
+		complete = complete && ok
+	}
+	for _, field := range decl.Type.Params.List {
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{{Name: "_"}}
+		}
+		for _, name := range names {
+			usage, ok := h.paramUsage(decl, name.Name, depth)
+			complete = complete && ok
+			s.params = append(s.params, usage)
+		}
+	}
+	if complete {
+		h.memo[fn] = s
+	}
+	return s, complete
+}
+
+// paramUsage summarizes one parameter of decl. On top of usage, a whole value written
+// through a pointer parameter (*dst = UserDTO{...}) sets the literal's keys.
+func (h *helperSummaries) paramUsage(decl *ast.FuncDecl, name string, depth int) (varUsage, bool) {
+	if name == "_" || name == "" {
+		return varUsage{fields: make(UsageLookup), methods: make(UsageLookup), setters: make(UsageLookup)}, true
+	}
+	param := resolveVar(h.pass.TypesInfo, decl.Body, name)
+	usage, complete := h.usage(decl.Body, param, depth)
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok || len(assign.Lhs) != len(assign.Rhs) {
+			return true
+		}
+		for i, lhs := range assign.Lhs {
+			if star, isStar := lhs.(*ast.StarExpr); isStar && param.ref(star.X) {
+				if cl := unwrapCompositeLit(assign.Rhs[i]); cl != nil {
+					extractKeysFromCompositeLit(cl, usage.fields)
+				}
+			}
+		}
+		return true
+	})
+	return usage, complete
+}
@@ -1,6 +1,6 @@
 package sample_readme_example
 
-func ConvertUserToDTO(user User) UserDTO { // want "incomplete converter with missing fields:.*user\\.Email"
+func ConvertUserToDTO(user User) UserDTO { // want "incomplete converter with missing fields:.*user\\.Email" ConvertUserToDTO:"incompleteConverter"
 	return UserDTO{
 		ID:       user.ID,
 		Username: user.Username,
@@ -6,7 +6,7 @@ import (
 )
 
 // Missing deeply nested field in inline declaration
-func ConvertEventToDTO_FullInlineDeclaration_Missed_Deep_Field(event domain.Event) dto.EventDTO { // want "incomplete converter with missing fields: event.User.Role.Name, User.Role.Name"
+func ConvertEventToDTO_FullInlineDeclaration_Missed_Deep_Field(event domain.Event) dto.EventDTO { // want "incomplete converter with missing fields: event.User.Role.Name, User.Role.Name" ConvertEventToDTO_FullInlineDeclaration_Missed_Deep_Field:"incompleteConverter"
 	return dto.EventDTO{
 		ID:    event.ID,
 		Title: event.Title,
@@ -38,7 +38,7 @@ func ConvertEventToDTO_FullInlineDeclaration_Missed_Deep_Field(event domain.Even
 }
 
 // Missing pointer field in inline declaration
-func ConvertEventToDTO_FullInlineDeclaration_Missed_Pointer_Field(event domain.Event) dto.EventDTO { // want "incomplete converter with missing fields: event.User.Group, User.Group"
+func ConvertEventToDTO_FullInlineDeclaration_Missed_Pointer_Field(event domain.Event) dto.EventDTO { // want "incomplete converter with missing fields: event.User.Group, User.Group" ConvertEventToDTO_FullInlineDeclaration_Missed_Pointer_Field:"incompleteConverter"
 	return dto.EventDTO{
 		ID:    event.ID,
 		Title: event.Title,
@@ -67,7 +67,7 @@ func ConvertEventToDTO_FullInlineDeclaration_Missed_Pointer_Field(event domain.E
 }
 
 // Missing field in mixed declaration and dot notation approach
-func ConvertEventToDTO_Mixed_Missed_Nested_Field(event domain.Event) (result dto.EventDTO) { // want "incomplete converter with missing fields: event.Owner.Group, result.Owner.Group"
+func ConvertEventToDTO_Mixed_Missed_Nested_Field(event domain.Event) (result dto.EventDTO) { // want "incomplete converter with missing fields: event.Owner.Group, result.Owner.Group" ConvertEventToDTO_Mixed_Missed_Nested_Field:"incompleteConverter"
 	result = dto.EventDTO{
 		ID:    event.ID,
 		Title: event.Title,
@@ -94,7 +94,7 @@ func ConvertEventToDTO_Mixed_Missed_Nested_Field(event domain.Event) (result dto
 }
 
 // Missing entire first-level nested struct
-func ConvertEventToDTO_DotNotation_Missed_First_Level(event domain.Event) (result dto.EventDTO) { // want "incomplete converter"
+func ConvertEventToDTO_DotNotation_Missed_First_Level(event domain.Event) (result dto.EventDTO) { // want "incomplete converter" ConvertEventToDTO_DotNotation_Missed_First_Level:"incompleteConverter"
 	result.ID = event.ID
 	result.Title = event.Title
 	// MISSING: result.User completely
@@ -6,7 +6,7 @@ import (
 
 // ConvertPetInfosToDTO_MissingWeight converts a slice of PetInfo to PetInfoDTO
 // but forgets to map the Weight field in both directions.
-func ConvertPetInfosToDTO_MissingWeight(records []models.PetInfo) []models.PetInfoDTO { // want "ConvertPetInfosToDTO_MissingWeight"
+func ConvertPetInfosToDTO_MissingWeight(records []models.PetInfo) []models.PetInfoDTO { // want "ConvertPetInfosToDTO_MissingWeight" ConvertPetInfosToDTO_MissingWeight:"incompleteConverter"
 	if records == nil {
 		return nil
 	}
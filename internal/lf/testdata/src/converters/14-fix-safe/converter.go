@@ -6,7 +6,7 @@ import (
 )
 
 // ConvertUserToDTO_MissingFields is missing Email and Phone fields
-func ConvertUserToDTO_MissingFields(user domain.User) (result *dto.UserDTO) { // want "incomplete converter"
+func ConvertUserToDTO_MissingFields(user domain.User) (result *dto.UserDTO) { // want "incomplete converter" ConvertUserToDTO_MissingFields:"incompleteConverter"
 	result = &dto.UserDTO{
 		ID:   user.ID,
 		Name: user.Name,
@@ -6,7 +6,7 @@ import (
 )
 
 // ConvertProductToDTO_MissingFields is missing Price and Category
-func ConvertProductToDTO_MissingFields(product domain.Product) (result *dto.ProductDTO) { // want "incomplete converter"
+func ConvertProductToDTO_MissingFields(product domain.Product) (result *dto.ProductDTO) { // want "incomplete converter" ConvertProductToDTO_MissingFields:"incompleteConverter"
 	result = &dto.ProductDTO{
 		ID:   product.ID,
 		Name: product.Name,
@@ -20,7 +20,7 @@ type ArticleDTO struct {
 
 // ConvertArticle skips CreatedAt/UpdatedAt. Without exclude-fields configured,
 // the skipped timestamps are reported as missing.
-func ConvertArticle(a Article) ArticleDTO { // want "incomplete converter with missing fields: a.CreatedAt, a.UpdatedAt"
+func ConvertArticle(a Article) ArticleDTO { // want "incomplete converter with missing fields: a.CreatedAt, a.UpdatedAt" ConvertArticle:"incompleteConverter"
 	return ArticleDTO{
 		ID:    a.ID,
 		Title: a.Title,
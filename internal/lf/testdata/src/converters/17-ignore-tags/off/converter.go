@@ -17,7 +17,7 @@ type ProductDTO struct {
 
 // ConvertProduct skips the tagged fields. Without ignore-tags configured,
 // the tags have no effect and the skipped fields are reported.
-func ConvertProduct(p Product) ProductDTO { // want "incomplete converter with missing fields: p.Secret, p.Audit, p.Comments"
+func ConvertProduct(p Product) ProductDTO { // want "incomplete converter with missing fields: p.Secret, p.Audit, p.Comments" ConvertProduct:"incompleteConverter"
 	return ProductDTO{
 		ID:   p.ID,
 		Name: p.Name,
@@ -40,7 +40,7 @@ type UserModelDTO struct {
 
 // ConvertUser is an incomplete converter that must still be reported
 // when min-similarity is enabled.
-func ConvertUser(u UserModel) UserModelDTO { // want "incomplete converter with missing fields: u.Name, Name"
+func ConvertUser(u UserModel) UserModelDTO { // want "incomplete converter with missing fields: u.Name, Name" ConvertUser:"incompleteConverter"
 	return UserModelDTO{
 		ID: u.ID,
 	}
@@ -20,7 +20,7 @@ type Category struct {
 // AggregateDetailsIncomplete is an aggregating converter (slice -> non-slice)
 // that drops Sections on both sides: detail.Sections is never read and
 // Category.Sections is never set.
-func AggregateDetailsIncomplete(details []*VenueDetail) Metadata { // want "incomplete converter with missing fields: detail.Sections, Categories\\[\\].Sections"
+func AggregateDetailsIncomplete(details []*VenueDetail) Metadata { // want "incomplete converter with missing fields: detail.Sections, Categories\\[\\].Sections" AggregateDetailsIncomplete:"incompleteConverter"
 	categories := make([]Category, 0, len(details))
 
 	for _, detail := range details {
@@ -6,7 +6,7 @@ import (
 )
 
 // ConvertSampleToDB_MissingPrice is missing the Price field
-func ConvertSampleToDB_MissingPrice(sample domain.Sample) (result *dbmodel.Sample) { // want "incomplete converter"
+func ConvertSampleToDB_MissingPrice(sample domain.Sample) (result *dbmodel.Sample) { // want "incomplete converter" ConvertSampleToDB_MissingPrice:"incompleteConverter"
 	_ = sample.Label
 	_ = sample.ID
 
@@ -22,7 +22,7 @@ func ConvertSampleToDB_MissingPrice(sample domain.Sample) (result *dbmodel.Sampl
 }
 
 // ConvertSampleToDB_MissingCurrency is missing the Currency field
-func ConvertSampleToDB_MissingCurrency(sample domain.Sample) (result *dbmodel.Sample) { // want "incomplete converter"
+func ConvertSampleToDB_MissingCurrency(sample domain.Sample) (result *dbmodel.Sample) { // want "incomplete converter" ConvertSampleToDB_MissingCurrency:"incompleteConverter"
 	_ = sample.Label
 	_ = sample.ID
 
@@ -37,7 +37,7 @@ func ConvertSampleToDB_MissingCurrency(sample domain.Sample) (result *dbmodel.Sa
 }
 
 // ConvertSampleToDB_MissingBoth is missing both Price and Currency fields
-func ConvertSampleToDB_MissingBoth(sample domain.Sample) (result *dbmodel.Sample) { // want "incomplete converter"
+func ConvertSampleToDB_MissingBoth(sample domain.Sample) (result *dbmodel.Sample) { // want "incomplete converter" ConvertSampleToDB_MissingBoth:"incompleteConverter"
 	_ = sample.Label
 	_ = sample.ID
 
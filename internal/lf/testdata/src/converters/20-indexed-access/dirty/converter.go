@@ -5,7 +5,7 @@ import (
 )
 
 // ConvertCuesToDTO_MissingText drops Text while reading through the index.
-func ConvertCuesToDTO_MissingText(in []models.Cue) []models.CueDTO { // want "ConvertCuesToDTO_MissingText"
+func ConvertCuesToDTO_MissingText(in []models.Cue) []models.CueDTO { // want "ConvertCuesToDTO_MissingText" ConvertCuesToDTO_MissingText:"incompleteConverter"
 	out := make([]models.CueDTO, len(in))
 	for i := range in {
 		out[i] = models.CueDTO{
@@ -17,7 +17,7 @@ func ConvertCuesToDTO_MissingText(in []models.Cue) []models.CueDTO { // want "Co
 }
 
 // ConvertCuesToDTOIndexedWrite_MissingText drops Text while writing through the index.
-func ConvertCuesToDTOIndexedWrite_MissingText(in []models.Cue) []models.CueDTO { // want "ConvertCuesToDTOIndexedWrite_MissingText"
+func ConvertCuesToDTOIndexedWrite_MissingText(in []models.Cue) []models.CueDTO { // want "ConvertCuesToDTOIndexedWrite_MissingText" ConvertCuesToDTOIndexedWrite_MissingText:"incompleteConverter"
 	out := make([]models.CueDTO, len(in))
 	for i := range in {
 		out[i].Start = in[i].Start
@@ -27,7 +27,7 @@ func ConvertCuesToDTOIndexedWrite_MissingText(in []models.Cue) []models.CueDTO {
 }
 
 // ConvertCuesToDTONamedResult_MissingText is the same with a named result.
-func ConvertCuesToDTONamedResult_MissingText(in []models.Cue) (out []models.CueDTO) { // want "ConvertCuesToDTONamedResult_MissingText"
+func ConvertCuesToDTONamedResult_MissingText(in []models.Cue) (out []models.CueDTO) { // want "ConvertCuesToDTONamedResult_MissingText" ConvertCuesToDTONamedResult_MissingText:"incompleteConverter"
 	out = make([]models.CueDTO, len(in))
 	for i := range in {
 		out[i].Start = in[i].Start
@@ -37,7 +37,7 @@ func ConvertCuesToDTONamedResult_MissingText(in []models.Cue) (out []models.CueD
 }
 
 // ConvertCueMapToDTO_MissingText drops Text in a map converter.
-func ConvertCueMapToDTO_MissingText(in map[string]models.Cue) map[string]models.CueDTO { // want "ConvertCueMapToDTO_MissingText"
+func ConvertCueMapToDTO_MissingText(in map[string]models.Cue) map[string]models.CueDTO { // want "ConvertCueMapToDTO_MissingText" ConvertCueMapToDTO_MissingText:"incompleteConverter"
 	out := make(map[string]models.CueDTO, len(in))
 	for k, v := range in {
 		out[k] = models.CueDTO{
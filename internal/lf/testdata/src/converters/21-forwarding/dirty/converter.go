@@ -15,7 +15,7 @@ func ConvertLocation(in models.Location) models.LocationDTO {
 // ConvertLocationMixed_MissingTitle forwards on one branch but builds the output itself
 // on another, dropping Title there. Forwarding somewhere does not excuse the branch that
 // maps by hand, so this is still reported.
-func ConvertLocationMixed_MissingTitle(in models.Location, curator bool) models.LocationDTO { // want "ConvertLocationMixed_MissingTitle"
+func ConvertLocationMixed_MissingTitle(in models.Location, curator bool) models.LocationDTO { // want "ConvertLocationMixed_MissingTitle" ConvertLocationMixed_MissingTitle:"incompleteConverter"
 	if curator {
 		return ConvertLocation(in)
 	}
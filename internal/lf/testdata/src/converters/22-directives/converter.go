@@ -0,0 +1,77 @@
+package sample_directives
+
+// Account is the domain model.
+type Account struct {
+	ID    int64
+	Email string
+	Plan  string
+}
+
+// ProfileResponse is the API model. Its name shares nothing with Account, so only the
+// //lostfield:converter directive gets the pair analyzed.
+type ProfileResponse struct {
+	ID    int64
+	Email string
+	Plan  string
+}
+
+// User is a second domain model.
+type User struct {
+	ID   int64
+	Name string
+}
+
+// UserDTO is its API model.
+type UserDTO struct {
+	ID   int64
+	Name string
+}
+
+// AccountToProfile is forced into analysis and drops Plan on both sides.
+//
+//lostfield:converter
+func AccountToProfile(a Account) ProfileResponse { // want "AccountToProfile: incomplete converter with missing fields: a.Plan, Plan" AccountToProfile:"incompleteConverter"
+	return ProfileResponse{
+		ID:    a.ID,
+		Email: a.Email,
+	}
+}
+
+// NewProfileFromAccount is a constructor by name, but the directive overrides that.
+//
+//lostfield:converter -- maps the account 1:1, despite the New prefix
+func NewProfileFromAccount(a Account) ProfileResponse {
+	return ProfileResponse{
+		ID:    a.ID,
+		Email: a.Email,
+		Plan:  a.Plan,
+	}
+}
+
+// ConvertUserLegacy is incomplete on purpose and says so.
+//
+//lostfield:ignore -- the legacy API never exposed names
+func ConvertUserLegacy(u User) UserDTO {
+	return UserDTO{ID: u.ID}
+}
+
+// ConvertUser is complete, so its ignore directive suppresses nothing.
+//
+//lostfield:ignore // want "ConvertUser: unused //lostfield:ignore directive"
+func ConvertUser(u User) UserDTO {
+	return UserDTO{ID: u.ID, Name: u.Name}
+}
+
+// Sum has nothing a converter directive could apply to.
+//
+//lostfield:converter // want "Sum: //lostfield:converter directive has no effect"
+func Sum(a, b int) int {
+	return a + b
+}
+
+// AccountToProfileTypo misspells its directive, which is reported instead of ignored.
+//
+//lostfield:ignroe // want "unknown directive //lostfield:ignroe"
+func AccountToProfileTypo(a Account) ProfileResponse { // want AccountToProfileTypo:`fieldUsage\(a: Email, ID, Plan\)`
+	return ProfileResponse{ID: a.ID, Email: a.Email, Plan: a.Plan}
+}
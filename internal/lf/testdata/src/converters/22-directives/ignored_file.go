@@ -0,0 +1,8 @@
+//lostfield:file-ignore -- generated-like glue, reviewed by hand
+
+package sample_directives
+
+// ConvertUserShort drops Name, but the whole file is skipped.
+func ConvertUserShort(u User) UserDTO {
+	return UserDTO{ID: u.ID}
+}
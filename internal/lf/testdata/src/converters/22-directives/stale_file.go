@@ -0,0 +1,8 @@
+//lostfield:file-ignore // want "unused //lostfield:file-ignore directive"
+
+package sample_directives
+
+// ConvertUserFull is complete, so the file-level directive above suppresses nothing.
+func ConvertUserFull(u User) UserDTO {
+	return UserDTO{ID: u.ID, Name: u.Name}
+}
@@ -0,0 +1,51 @@
+package sample_acknowledgements_clean
+
+import (
+	models "converters/23-field-acknowledgements/models"
+)
+
+// ConvertUserSkipEmail acknowledges Email on both sides with one directive.
+func ConvertUserSkipEmail(in models.User) models.UserDTO {
+	//lostfield:skip Email -- emails are exposed through a separate endpoint
+	return models.UserDTO{
+		ID:   in.ID,
+		Role: models.RoleDTO{ID: in.Role.ID, Name: in.Role.Name},
+	}
+}
+
+// ConvertUserOmittedComment reads Email for logging but deliberately leaves it unset
+// in the output, saying so inside the literal.
+func ConvertUserOmittedComment(in models.User) models.UserDTO {
+	audit(in.Email)
+	return models.UserDTO{
+		ID: in.ID,
+		// Email: intentionally omitted, the API hides it
+		Role: models.RoleDTO{ID: in.Role.ID, Name: in.Role.Name},
+	}
+}
+
+// ConvertUserNestedOmitted acknowledges a nested output field from inside the nested
+// literal, and the matching input field with a side-scoped directive.
+//
+//lostfield:skip in.Role.Name
+func ConvertUserNestedOmitted(in models.User) models.UserDTO {
+	return models.UserDTO{
+		ID:    in.ID,
+		Email: in.Email,
+		Role: models.RoleDTO{
+			ID: in.Role.ID,
+			// Name: intentionally omitted
+		},
+	}
+}
+
+// ConvertUserNestedPath acknowledges a nested path on both sides at once.
+func ConvertUserNestedPath(in models.User) (out models.UserDTO) {
+	//lostfield:skip Role.Name
+	out.ID = in.ID
+	out.Email = in.Email
+	out.Role.ID = in.Role.ID
+	return out
+}
+
+func audit(string) {}
@@ -0,0 +1,37 @@
+package sample_acknowledgements_dirty
+
+import (
+	models "converters/23-field-acknowledgements/models"
+)
+
+// ConvertUserSkipInputOnly acknowledges the input side only, so the unset output
+// Email is still reported.
+func ConvertUserSkipInputOnly(in models.User) models.UserDTO { // want "ConvertUserSkipInputOnly: incomplete converter with missing fields: Email" ConvertUserSkipInputOnly:"incompleteConverter"
+	//lostfield:skip in.Email
+	return models.UserDTO{
+		ID:   in.ID,
+		Role: models.RoleDTO{ID: in.Role.ID, Name: in.Role.Name},
+	}
+}
+
+// ConvertUserOmittedWrongLevel places the comment in the outer literal, so it names
+// UserDTO.Name - which does not exist - and the nested Role.Name stays missing.
+func ConvertUserOmittedWrongLevel(in models.User) models.UserDTO { // want "ConvertUserOmittedWrongLevel: incomplete converter with missing fields: in.Role.Name, Role.Name" ConvertUserOmittedWrongLevel:"incompleteConverter"
+	return models.UserDTO{
+		ID:    in.ID,
+		Email: in.Email,
+		// Name: intentionally omitted
+		Role: models.RoleDTO{
+			ID: in.Role.ID,
+		},
+	}
+}
+
+// ConvertUserPlainComment has an ordinary comment, which acknowledges nothing.
+func ConvertUserPlainComment(in models.User) models.UserDTO { // want "ConvertUserPlainComment: incomplete converter with missing fields: in.Email, Email" ConvertUserPlainComment:"incompleteConverter"
+	return models.UserDTO{
+		ID: in.ID,
+		// Email is not needed here
+		Role: models.RoleDTO{ID: in.Role.ID, Name: in.Role.Name},
+	}
+}
@@ -0,0 +1,23 @@
+package modelsAcknowledgements
+
+type Role struct {
+	ID   string
+	Name string
+}
+
+type User struct {
+	ID    string
+	Email string
+	Role  Role
+}
+
+type RoleDTO struct {
+	ID   string
+	Name string
+}
+
+type UserDTO struct {
+	ID    string
+	Email string
+	Role  RoleDTO
+}
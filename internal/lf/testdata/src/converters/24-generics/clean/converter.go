@@ -0,0 +1,83 @@
+package sample_generics_clean
+
+import (
+	models "converters/24-generics/models"
+)
+
+func ConvertUser(in models.User) models.UserDTO {
+	return models.UserDTO{
+		ID:    in.ID,
+		Email: in.Email,
+		Name:  in.Name,
+	}
+}
+
+// ConvertUserPage maps an instantiated generic type; fields are checked against
+// Page[User] and Page[UserDTO].
+func ConvertUserPage(in models.Page[models.User]) models.Page[models.UserDTO] {
+	out := models.Page[models.UserDTO]{
+		Total:  in.Total,
+		Cursor: in.Cursor,
+	}
+	for _, u := range in.Items {
+		out.Items = append(out.Items, ConvertUser(u))
+	}
+	return out
+}
+
+// MapPage is fully generic: Page[T] -> Page[U] pairs on "Page".
+func MapPage[T, U any](in models.Page[T], f func(T) U) models.Page[U] {
+	items := make([]U, 0, len(in.Items))
+	for _, it := range in.Items {
+		items = append(items, f(it))
+	}
+	return models.Page[U]{
+		Items:  items,
+		Total:  in.Total,
+		Cursor: in.Cursor,
+	}
+}
+
+// WrapEntity hands its input on whole through the type argument.
+func WrapEntity[T models.Entity](in T) models.DTO[T] {
+	return models.DTO[T]{
+		Data:    in,
+		Version: 1,
+	}
+}
+
+// ConvertEntity forwards its input, converted to the core type, to another converter.
+func ConvertEntity[T models.Entity](in T) models.UserDTO {
+	return ConvertUser(models.User(in))
+}
+
+// BuildView fills a type parameter output through its core struct type.
+func BuildView[V models.UserView](in models.User) V {
+	return V{
+		ID:    in.ID,
+		Email: in.Email,
+		Name:  in.Name,
+	}
+}
+
+// SummarizePage is not a converter: Page[User] and Page[Order] differ by type argument.
+func SummarizePage(in models.Page[models.User]) models.Page[models.Order] { // want SummarizePage:`fieldUsage\(in: Total\)`
+	return models.Page[models.Order]{Total: in.Total}
+}
+
+// Mapper is a generic mapper; its methods are converters like any other.
+type Mapper[In models.Entity, Out models.UserView] struct {
+	conv func(In) Out
+}
+
+func (m Mapper[In, Out]) Map(in In) Out {
+	return m.conv(in)
+}
+
+func (m Mapper[In, Out]) ToDTO(in models.User) models.UserDTO {
+	return models.UserDTO{
+		ID:    in.ID,
+		Email: in.Email,
+		Name:  in.Name,
+	}
+}
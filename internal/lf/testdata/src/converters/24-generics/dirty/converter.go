@@ -0,0 +1,51 @@
+package sample_generics_dirty
+
+import (
+	models "converters/24-generics/models"
+)
+
+func ConvertUser(in models.User) models.UserDTO {
+	return models.UserDTO{
+		ID:    in.ID,
+		Email: in.Email,
+		Name:  in.Name,
+	}
+}
+
+// ConvertUserPage forgets the cursor of the instantiated Page.
+func ConvertUserPage(in models.Page[models.User]) models.Page[models.UserDTO] { // want "ConvertUserPage: incomplete converter with missing fields: in.Cursor, Cursor" ConvertUserPage:"incompleteConverter"
+	out := models.Page[models.UserDTO]{
+		Total: in.Total,
+	}
+	for _, u := range in.Items {
+		out.Items = append(out.Items, ConvertUser(u))
+	}
+	return out
+}
+
+// WrapEntity never uses its input, so nothing reaches the output.
+func WrapEntity[T models.Entity](in T) models.DTO[T] { // want "WrapEntity: incomplete converter with missing fields: in.ID, in.Email, in.Name, Data" WrapEntity:"incompleteConverter"
+	return models.DTO[T]{
+		Version: 1,
+	}
+}
+
+// BuildView leaves Name unset on the type parameter output.
+func BuildView[V models.UserView](in models.User) V { // want "BuildView: incomplete converter with missing fields: in.Name, Name" BuildView:"incompleteConverter"
+	return V{
+		ID:    in.ID,
+		Email: in.Email,
+	}
+}
+
+// Mapper is a generic mapper; its methods are checked like any other.
+type Mapper[In models.Entity, Out models.UserView] struct {
+	conv func(In) Out
+}
+
+func (m Mapper[In, Out]) ToDTO(in models.User) models.UserDTO { // want "ToDTO: incomplete converter with missing fields: in.Email, Email" ToDTO:"incompleteConverter"
+	return models.UserDTO{
+		ID:   in.ID,
+		Name: in.Name,
+	}
+}
@@ -0,0 +1,41 @@
+package modelsGenerics
+
+type User struct {
+	ID    string
+	Email string
+	Name  string
+}
+
+type UserDTO struct {
+	ID    string
+	Email string
+	Name  string
+}
+
+type Order struct {
+	ID    string
+	Total int
+}
+
+// Page is a generic page of results, as returned by repositories.
+type Page[T any] struct {
+	Items  []T
+	Total  int
+	Cursor string
+}
+
+// DTO wraps any entity with transport metadata.
+type DTO[T any] struct {
+	Data    T
+	Version int
+}
+
+// Entity admits only User, so User is its core type.
+type Entity interface {
+	User
+}
+
+// UserView admits only UserDTO.
+type UserView interface {
+	UserDTO
+}
@@ -0,0 +1,53 @@
+package sample_multi_input_clean
+
+import (
+	"strings"
+
+	models "converters/25-multi-input/models"
+)
+
+// ToUserProfile fills the output from both inputs.
+func ToUserProfile(u models.User, s models.UserSettings) models.UserProfile {
+	return models.UserProfile{
+		ID:       u.ID,
+		Name:     u.Name,
+		Theme:    s.Theme,
+		Language: s.Language,
+	}
+}
+
+// ToUserProfileDefaultLanguage acknowledges the unread field of its second input.
+func ToUserProfileDefaultLanguage(u *models.User, s *models.UserSettings) *models.UserProfile {
+	//lostfield:skip s.Language -- profiles are always rendered in English
+	return &models.UserProfile{
+		ID:       u.ID,
+		Name:     u.Name,
+		Theme:    s.Theme,
+		Language: "en",
+	}
+}
+
+// ToUserProfileWithOptions takes options steering the conversion: they are no input, and
+// the fields it does not read are not reported.
+func ToUserProfileWithOptions(u models.User, s models.UserSettings, opts *models.RenderOptions) models.UserProfile {
+	name := u.Name
+	if opts.Uppercase {
+		name = strings.ToUpper(name)
+	}
+	return models.UserProfile{
+		ID:       u.ID,
+		Name:     name,
+		Theme:    s.Theme,
+		Language: s.Language,
+	}
+}
+
+// ToUserProfileWithoutSettings deliberately ignores the blank settings parameter.
+func ToUserProfileWithoutSettings(u models.User, _ models.UserSettings) models.UserProfile {
+	return models.UserProfile{
+		ID:   u.ID,
+		Name: u.Name,
+		// Theme: intentionally omitted
+		// Language: intentionally omitted
+	}
+}
@@ -0,0 +1,22 @@
+package sample_multi_input_dirty
+
+import (
+	models "converters/25-multi-input/models"
+)
+
+// ToUserProfile never reads the language from its second input.
+func ToUserProfile(u models.User, s models.UserSettings) models.UserProfile { // want "ToUserProfile: incomplete converter with missing fields: s.Language, Language" ToUserProfile:"incompleteConverter"
+	return models.UserProfile{
+		ID:    u.ID,
+		Name:  u.Name,
+		Theme: s.Theme,
+	}
+}
+
+// ToUserProfilePartial loses a field of each input; each is reported under its own name.
+func ToUserProfilePartial(u models.User, s *models.UserSettings) models.UserProfile { // want "ToUserProfilePartial: incomplete converter with missing fields: u.Name, s.Theme, Name, Theme" ToUserProfilePartial:"incompleteConverter"
+	return models.UserProfile{
+		ID:       u.ID,
+		Language: s.Language,
+	}
+}
@@ -0,0 +1,25 @@
+package modelsMultiInput
+
+type User struct {
+	ID   string
+	Name string
+}
+
+type UserSettings struct {
+	Theme    string
+	Language string
+}
+
+// RenderOptions steer a conversion without being converted.
+type RenderOptions struct {
+	Uppercase bool
+	Verbose   bool
+}
+
+// UserProfile merges a User and its UserSettings.
+type UserProfile struct {
+	ID       string
+	Name     string
+	Theme    string
+	Language string
+}
@@ -0,0 +1,32 @@
+package sample_multi_output_clean
+
+import (
+	models "converters/26-multi-output/models"
+	v2 "converters/26-multi-output/v2"
+)
+
+// SplitOrder returns the order and its lines as separate outputs.
+func SplitOrder(o models.Order) (models.OrderDTO, []models.LineDTO, error) {
+	var lines []models.LineDTO
+	for _, l := range o.Lines {
+		lines = append(lines, models.LineDTO{SKU: l.SKU, Qty: l.Qty})
+	}
+	return models.OrderDTO{ID: o.ID, Customer: o.Customer}, lines, nil
+}
+
+// SplitOrderNamed does the same through named results.
+func SplitOrderNamed(o models.Order) (dto models.OrderDTO, lines []models.LineDTO, err error) {
+	dto.ID = o.ID
+	dto.Customer = o.Customer
+	for _, l := range o.Lines {
+		lines = append(lines, models.LineDTO{SKU: l.SKU, Qty: l.Qty})
+	}
+	return dto, lines, nil
+}
+
+// ToOrderDTOs builds both API versions at once.
+func ToOrderDTOs(o models.Order) (models.OrderDTO, v2.OrderDTO) {
+	_ = o.Lines
+	return models.OrderDTO{ID: o.ID, Customer: o.Customer},
+		v2.OrderDTO{ID: o.ID, Customer: o.Customer}
+}
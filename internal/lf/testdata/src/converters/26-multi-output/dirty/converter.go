@@ -0,0 +1,32 @@
+package sample_multi_output_dirty
+
+import (
+	models "converters/26-multi-output/models"
+	v2 "converters/26-multi-output/v2"
+)
+
+// SplitOrder drops the quantity of every line.
+func SplitOrder(o models.Order) (models.OrderDTO, []models.LineDTO, error) { // want "SplitOrder: incomplete converter with missing fields: LineDTO.Qty" SplitOrder:"incompleteConverter"
+	var lines []models.LineDTO
+	for _, l := range o.Lines {
+		lines = append(lines, models.LineDTO{SKU: l.SKU})
+	}
+	return models.OrderDTO{ID: o.ID, Customer: o.Customer}, lines, nil
+}
+
+// SplitOrderNamed forgets the customer on the named order result.
+func SplitOrderNamed(o models.Order) (dto models.OrderDTO, lines []models.LineDTO, err error) { // want "SplitOrderNamed: incomplete converter with missing fields: o.Customer, dto.Customer" SplitOrderNamed:"incompleteConverter"
+	dto.ID = o.ID
+	for _, l := range o.Lines {
+		lines = append(lines, models.LineDTO{SKU: l.SKU, Qty: l.Qty})
+	}
+	return dto, lines, nil
+}
+
+// ToOrderDTOs leaves the customer out of the second version only: the first literal
+// does not count for the second result, although both types are named OrderDTO.
+func ToOrderDTOs(o models.Order) (models.OrderDTO, v2.OrderDTO) { // want "ToOrderDTOs: incomplete converter with missing fields: OrderDTO.Customer" ToOrderDTOs:"incompleteConverter"
+	_ = o.Lines
+	return models.OrderDTO{ID: o.ID, Customer: o.Customer},
+		v2.OrderDTO{ID: o.ID}
+}
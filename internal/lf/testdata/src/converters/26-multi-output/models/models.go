@@ -0,0 +1,22 @@
+package modelsMultiOutput
+
+type Line struct {
+	SKU string
+	Qty int
+}
+
+type Order struct {
+	ID       string
+	Customer string
+	Lines    []Line
+}
+
+type OrderDTO struct {
+	ID       string
+	Customer string
+}
+
+type LineDTO struct {
+	SKU string
+	Qty int
+}
@@ -0,0 +1,7 @@
+// Package v2 holds the next API version, whose types share names with the first.
+package v2
+
+type OrderDTO struct {
+	ID       string
+	Customer string
+}
@@ -0,0 +1,60 @@
+package sample_receiver_input_clean
+
+import (
+	models "converters/27-receiver-input/models"
+)
+
+type Role struct {
+	Name string
+}
+
+type User struct {
+	ID    string
+	Email string
+	Role  Role
+}
+
+func (u User) GetEmail() string { // want GetEmail:`fieldUsage\(u: Email\)`
+	return u.Email
+}
+
+// ToDTO is a receiver-only converter: the receiver is its input. The getter and the
+// nested chain count as reads of the receiver.
+func (u User) ToDTO() models.UserDTO {
+	return models.UserDTO{
+		ID:       u.ID,
+		Email:    u.GetEmail(),
+		RoleName: u.Role.Name,
+	}
+}
+
+// ToDTOPtr does the same through a pointer receiver and dot assignments.
+func (u *User) ToDTOPtr() *models.UserDTO {
+	out := &models.UserDTO{}
+	out.ID = u.ID
+	out.Email = u.Email
+	out.RoleName = u.Role.Name
+	return out
+}
+
+// ToDTOVia forwards the receiver to a converter function.
+func (u User) ToDTOVia() models.UserDTO {
+	return convertUser(u)
+}
+
+func convertUser(u User) models.UserDTO {
+	return models.UserDTO{
+		ID:       u.ID,
+		Email:    u.Email,
+		RoleName: u.Role.Name,
+	}
+}
+
+// Registry is a service, not a model: with a struct parameter it is not the input.
+type Registry struct {
+	users map[string]User
+}
+
+func (r *Registry) UserDTO(u User) models.UserDTO {
+	return u.ToDTO()
+}
@@ -0,0 +1,35 @@
+package sample_receiver_input_dirty
+
+import (
+	models "converters/27-receiver-input/models"
+)
+
+type Role struct {
+	Name string
+}
+
+type User struct {
+	ID    string
+	Email string
+	Role  Role
+}
+
+func (u User) GetEmail() string { // want GetEmail:`fieldUsage\(u: Email\)`
+	return u.Email
+}
+
+// ToDTO never maps the role.
+func (u User) ToDTO() models.UserDTO { // want "ToDTO: incomplete converter with missing fields: u.Role, RoleName" ToDTO:"incompleteConverter"
+	return models.UserDTO{
+		ID:    u.ID,
+		Email: u.GetEmail(),
+	}
+}
+
+// ToDTOPtr forgets the email.
+func (u *User) ToDTOPtr() *models.UserDTO { // want "ToDTOPtr: incomplete converter with missing fields: u.Email, Email" ToDTOPtr:"incompleteConverter"
+	out := &models.UserDTO{}
+	out.ID = u.ID
+	out.RoleName = u.Role.Name
+	return out
+}
@@ -0,0 +1,18 @@
+package modelsReceiverInput
+
+type UserDTO struct {
+	ID       string
+	Email    string
+	RoleName string
+}
+
+type Settings struct {
+	Theme    string
+	Language string
+}
+
+type UserProfile struct {
+	ID       string
+	Theme    string
+	Language string
+}
@@ -0,0 +1,18 @@
+package sample_receiver_input_prefer
+
+import (
+	models "converters/27-receiver-input/models"
+)
+
+type UserProfileSource struct {
+	ID string
+}
+
+// ToUserProfile merges the receiver with its settings parameter. Only receiver-input
+// "prefer" makes the receiver the input here; by default the parameter would be.
+func (u UserProfileSource) ToUserProfile(s models.Settings) models.UserProfile { // want "ToUserProfile: incomplete converter with missing fields: s.Language, Language" ToUserProfile:"incompleteConverter"
+	return models.UserProfile{
+		ID:    u.ID,
+		Theme: s.Theme,
+	}
+}
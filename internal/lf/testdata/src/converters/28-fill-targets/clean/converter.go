@@ -0,0 +1,51 @@
+package sample_fill_targets_clean
+
+import (
+	models "converters/28-fill-targets/models"
+)
+
+// fillDTO writes its output through a pointer parameter.
+func fillDTO(dst *models.UserDTO, src models.User) {
+	dst.ID = src.ID
+	dst.Email = src.Email
+	dst.Name = src.Name
+}
+
+// fillDTOLiteral replaces the pointed-to value at once.
+func fillDTOLiteral(dst *models.UserDTO, src *models.User) {
+	*dst = models.UserDTO{
+		ID:    src.ID,
+		Email: src.Email,
+		Name:  src.Name,
+	}
+}
+
+// applyPatch writes the patch onto the user; the pointer written through is the output.
+func applyPatch(dst *models.User, patch models.UserPatch) error {
+	//lostfield:skip dst.ID -- identity is never patched
+	dst.Email = patch.Email
+	dst.Name = patch.Name
+	return nil
+}
+
+type UserView struct {
+	ID    string
+	Email string
+	Name  string
+}
+
+// FromDomain fills its pointer receiver.
+func (v *UserView) FromDomain(u models.User) {
+	v.ID = u.ID
+	v.Email = u.Email
+	v.Name = u.Name
+}
+
+// FromDomainVia delegates to the fill function.
+func (v *UserView) FromDomainVia(u models.User) { // want FromDomainVia:`fieldUsage\(v: Email, ID, Name; u: Email, ID, Name\)`
+	fillView(v, u)
+}
+
+func fillView(dst *UserView, u models.User) {
+	dst.FromDomain(u)
+}
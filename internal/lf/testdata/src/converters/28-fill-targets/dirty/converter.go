@@ -0,0 +1,30 @@
+package sample_fill_targets_dirty
+
+import (
+	models "converters/28-fill-targets/models"
+)
+
+// fillDTO forgets the name.
+func fillDTO(dst *models.UserDTO, src models.User) { // want "fillDTO: incomplete converter with missing fields: src.Name, dst.Name"
+	dst.ID = src.ID
+	dst.Email = src.Email
+}
+
+// applyPatch forgets to apply the name.
+func applyPatch(dst *models.User, patch models.UserPatch) error { // want "applyPatch: incomplete converter with missing fields: patch.Name, dst.Name"
+	//lostfield:skip dst.ID -- identity is never patched
+	dst.Email = patch.Email
+	return nil
+}
+
+type UserView struct {
+	ID    string
+	Email string
+	Name  string
+}
+
+// FromDomain forgets the email.
+func (v *UserView) FromDomain(u models.User) { // want "FromDomain: incomplete converter with missing fields: u.Email, v.Email" FromDomain:"incompleteConverter"
+	v.ID = u.ID
+	v.Name = u.Name
+}
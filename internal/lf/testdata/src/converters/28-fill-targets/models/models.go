@@ -0,0 +1,19 @@
+package modelsFillTargets
+
+type User struct {
+	ID    string
+	Email string
+	Name  string
+}
+
+type UserDTO struct {
+	ID    string
+	Email string
+	Name  string
+}
+
+// UserPatch carries the fields a user may change.
+type UserPatch struct {
+	Email string
+	Name  string
+}
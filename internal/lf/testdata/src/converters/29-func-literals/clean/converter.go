@@ -0,0 +1,70 @@
+package sample_func_literals_clean
+
+import (
+	models "converters/29-func-literals/models"
+)
+
+type Kind int
+
+const (
+	KindUser Kind = iota
+	KindAdmin
+)
+
+// toDTO is a converter held in a variable.
+var toDTO = func(u models.User) models.UserDTO {
+	return models.UserDTO{
+		ID:    u.ID,
+		Email: u.Email,
+		Name:  u.Name,
+	}
+}
+
+// converters dispatches on the kind; every entry maps in full.
+var converters = map[Kind]func(models.User) models.UserDTO{
+	KindUser: func(u models.User) models.UserDTO {
+		return models.UserDTO{ID: u.ID, Email: u.Email, Name: u.Name}
+	},
+	KindAdmin: toDTO,
+}
+
+// Handlers holds converters as fields.
+type Handlers struct {
+	ToDTO func(models.User) models.UserDTO
+}
+
+var handlers = Handlers{
+	ToDTO: func(u models.User) models.UserDTO {
+		return toDTO(u)
+	},
+}
+
+// legacyDTO is kept as it was on purpose.
+//
+//lostfield:ignore -- frozen wire format
+var legacyDTO = func(u models.User) models.UserDTO {
+	return models.UserDTO{ID: u.ID}
+}
+
+func mapAll(users []models.User, f func(models.User) models.UserDTO) []models.UserDTO {
+	res := make([]models.UserDTO, 0, len(users))
+	for _, u := range users {
+		res = append(res, f(u))
+	}
+	return res
+}
+
+// ExportUsers converts through a closure that maps every field.
+func ExportUsers(users []models.User) []models.UserDTO {
+	return mapAll(users, func(u models.User) models.UserDTO {
+		return models.UserDTO{ID: u.ID, Email: u.Email, Name: u.Name}
+	})
+}
+
+// ExportUser converts through a local closure.
+func ExportUser(u models.User) models.UserDTO {
+	convert := func(in models.User) models.UserDTO {
+		return models.UserDTO{ID: in.ID, Email: in.Email, Name: in.Name}
+	}
+	return convert(u)
+}
@@ -0,0 +1,64 @@
+package sample_func_literals_dirty
+
+import (
+	models "converters/29-func-literals/models"
+)
+
+type Kind int
+
+const (
+	KindUser Kind = iota
+	KindAdmin
+)
+
+// toDTO forgets the name.
+var toDTO = func(u models.User) models.UserDTO { // want `toDTO: incomplete converter with missing fields: u.Name, Name`
+	return models.UserDTO{
+		ID:    u.ID,
+		Email: u.Email,
+	}
+}
+
+// converters dispatches on the kind; the admin entry forgets the email.
+var converters = map[Kind]func(models.User) models.UserDTO{
+	KindUser: func(u models.User) models.UserDTO { // want `converters\[KindUser\]: incomplete converter with missing fields: u.Name, Name`
+		return models.UserDTO{ID: u.ID, Email: u.Email}
+	},
+	KindAdmin: func(u models.User) models.UserDTO { // want `converters\[KindAdmin\]: incomplete converter with missing fields: u.Email, Email`
+		return models.UserDTO{ID: u.ID, Name: u.Name}
+	},
+}
+
+// Handlers holds converters as fields.
+type Handlers struct {
+	ToDTO func(models.User) models.UserDTO
+}
+
+var handlers = Handlers{
+	ToDTO: func(u models.User) models.UserDTO { // want `handlers.ToDTO: incomplete converter with missing fields: u.ID, ID`
+		return models.UserDTO{Email: u.Email, Name: u.Name}
+	},
+}
+
+func mapAll(users []models.User, f func(models.User) models.UserDTO) []models.UserDTO {
+	res := make([]models.UserDTO, 0, len(users))
+	for _, u := range users {
+		res = append(res, f(u))
+	}
+	return res
+}
+
+// ExportUsers converts through a closure that forgets the email.
+func ExportUsers(users []models.User) []models.UserDTO {
+	return mapAll(users, func(u models.User) models.UserDTO { // want `func literal passed to mapAll: incomplete converter with missing fields: u.Email, Email`
+		return models.UserDTO{ID: u.ID, Name: u.Name}
+	})
+}
+
+// ExportUser converts through a local closure that forgets the name.
+func ExportUser(u models.User) models.UserDTO {
+	convert := func(in models.User) models.UserDTO { // want `convert: incomplete converter with missing fields: in.Name, Name`
+		return models.UserDTO{ID: in.ID, Email: in.Email}
+	}
+	return convert(u)
+}
@@ -0,0 +1,13 @@
+package modelsFuncLiterals
+
+type User struct {
+	ID    string
+	Email string
+	Name  string
+}
+
+type UserDTO struct {
+	ID    string
+	Email string
+	Name  string
+}
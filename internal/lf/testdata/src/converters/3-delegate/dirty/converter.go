@@ -5,7 +5,7 @@ import (
 )
 
 // ConvertTicketModelToProto_Incomplete is missing several fields in the conversion
-func ConvertTicketModelToProto_Incomplete(t sampleDelegate.Ticket) *sampleDelegate.TicketProto { // want "incomplete converter"
+func ConvertTicketModelToProto_Incomplete(t sampleDelegate.Ticket) *sampleDelegate.TicketProto { // want "incomplete converter" ConvertTicketModelToProto_Incomplete:"incompleteConverter"
 	splits := make([]string, 0, len(t.ValidSplits)+len(t.IgnoredSplits))
 	splits = append(splits, t.ValidSplits...)
 	splits = append(splits, t.IgnoredSplits...)
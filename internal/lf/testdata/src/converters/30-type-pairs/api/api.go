@@ -0,0 +1,20 @@
+package api
+
+// ProfileResponse is what the API returns for an account.
+type ProfileResponse struct {
+	ID    string
+	Email string
+	Name  string
+}
+
+type Invoice struct {
+	ID    string
+	Total int64
+}
+
+// Account shares its name with the domain type.
+type Account struct {
+	ID    string
+	Email string
+	Name  string
+}
@@ -0,0 +1,22 @@
+package sample_type_pairs_default
+
+import (
+	"converters/30-type-pairs/api"
+	"converters/30-type-pairs/domain"
+)
+
+// ToProfile forgets the name, but without a type pair its names do not match.
+func ToProfile(a domain.Account) api.ProfileResponse { // want ToProfile:`fieldUsage\(a: Email, ID\)`
+	return api.ProfileResponse{
+		ID:    a.ID,
+		Email: a.Email,
+	}
+}
+
+// ToAPIAccount forgets the email; same-named types across packages always match.
+func ToAPIAccount(a domain.Account) api.Account { // want "ToAPIAccount" ToAPIAccount:"incompleteConverter"
+	return api.Account{
+		ID:   a.ID,
+		Name: a.Name,
+	}
+}
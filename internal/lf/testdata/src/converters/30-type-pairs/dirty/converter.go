@@ -0,0 +1,34 @@
+package sample_type_pairs_dirty
+
+import (
+	"converters/30-type-pairs/api"
+	"converters/30-type-pairs/domain"
+)
+
+// ToProfile forgets the name. Its type names share nothing: only a type pair finds it.
+func ToProfile(a domain.Account) api.ProfileResponse { // want "ToProfile" ToProfile:"incompleteConverter"
+	return api.ProfileResponse{
+		ID:    a.ID,
+		Email: a.Email,
+	}
+}
+
+// ToInvoice forgets the total.
+func ToInvoice(o domain.Order) api.Invoice { // want "ToInvoice" ToInvoice:"incompleteConverter"
+	return api.Invoice{
+		ID: o.ID,
+	}
+}
+
+// ToAPIAccount forgets the email; same-named types match with or without pairs.
+func ToAPIAccount(a domain.Account) api.Account { // want "ToAPIAccount" ToAPIAccount:"incompleteConverter"
+	return api.Account{
+		ID:   a.ID,
+		Name: a.Name,
+	}
+}
+
+// SummarizeOrder is not a listed pair, so it stays out of the analysis.
+func SummarizeOrder(o domain.Order) api.ProfileResponse { // want SummarizeOrder:`fieldUsage\(o: ID\)`
+	return api.ProfileResponse{ID: o.ID}
+}
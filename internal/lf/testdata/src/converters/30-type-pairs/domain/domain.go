@@ -0,0 +1,12 @@
+package domain
+
+type Account struct {
+	ID    string
+	Email string
+	Name  string
+}
+
+type Order struct {
+	ID    string
+	Total int64
+}
@@ -0,0 +1,10 @@
+package sample_package_pairs_default
+
+import (
+	"converters/31-package-pairs/dto"
+)
+
+// ReplyParams is taken for a converter when no package pairs are configured.
+func ReplyParams(m dto.Message) dto.MessageNewParams { // want "ReplyParams" ReplyParams:"incompleteConverter"
+	return dto.MessageNewParams{Body: m.Body}
+}
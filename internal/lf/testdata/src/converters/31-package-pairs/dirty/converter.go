@@ -0,0 +1,24 @@
+package sample_package_pairs_dirty
+
+import (
+	"converters/31-package-pairs/domain"
+	"converters/31-package-pairs/dto"
+)
+
+// ToUserDTO crosses from domain to dto and forgets the name.
+func ToUserDTO(u domain.User) dto.UserDTO { // want "ToUserDTO" ToUserDTO:"incompleteConverter"
+	return dto.UserDTO{
+		ID:    u.ID,
+		Email: u.Email,
+	}
+}
+
+// SnapshotAccount crosses layers, but min-similarity still rules its names out.
+func SnapshotAccount(a domain.Account) dto.AccountBalanceSnapshot { // want SnapshotAccount:`fieldUsage\(a: ID\)`
+	return dto.AccountBalanceSnapshot{ID: a.ID}
+}
+
+// ReplyParams stays inside dto: not a conversion between listed packages.
+func ReplyParams(m dto.Message) dto.MessageNewParams { // want ReplyParams:`fieldUsage\(m: Body\)`
+	return dto.MessageNewParams{Body: m.Body}
+}
@@ -0,0 +1,12 @@
+package domain
+
+type User struct {
+	ID    string
+	Email string
+	Name  string
+}
+
+type Account struct {
+	ID      string
+	Balance int64
+}
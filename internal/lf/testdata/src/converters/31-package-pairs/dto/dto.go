@@ -0,0 +1,25 @@
+package dto
+
+type UserDTO struct {
+	ID    string
+	Email string
+	Name  string
+}
+
+// AccountBalanceSnapshot merely shares a name fragment with domain.Account.
+type AccountBalanceSnapshot struct {
+	ID      string
+	Balance int64
+	TakenAt int64
+}
+
+// Message and MessageNewParams live side by side in one layer.
+type Message struct {
+	ID   string
+	Body string
+}
+
+type MessageNewParams struct {
+	Body    string
+	Channel string
+}
@@ -0,0 +1,26 @@
+package sample_name_matching_dirty
+
+import (
+	models "converters/32-name-matching/models"
+)
+
+// RecordToDTO forgets the name; UserRecord and UserDTO both normalize to User.
+func RecordToDTO(r models.UserRecord) models.UserDTO { // want "RecordToDTO" RecordToDTO:"incompleteConverter"
+	return models.UserDTO{
+		ID:    r.ID,
+		Email: r.Email,
+	}
+}
+
+// FromPB forgets the email; the PB prefix is stripped too.
+func FromPB(p *models.PBUser) models.UserRecord { // want "FromPB" FromPB:"incompleteConverter"
+	return models.UserRecord{
+		ID:   p.ID,
+		Name: p.Name,
+	}
+}
+
+// OrderBorder is no conversion: Order is not a token of BorderDTO.
+func OrderBorder(o models.Order) models.BorderDTO { // want OrderBorder:`fieldUsage\(o: ID\)`
+	return models.BorderDTO{ID: o.ID}
+}
@@ -0,0 +1,32 @@
+package modelsNameMatching
+
+// UserRecord is the storage row of a user.
+type UserRecord struct {
+	ID    string
+	Email string
+	Name  string
+}
+
+type UserDTO struct {
+	ID    string
+	Email string
+	Name  string
+}
+
+// PBUser is the protobuf message of a user.
+type PBUser struct {
+	ID    string
+	Email string
+	Name  string
+}
+
+type Order struct {
+	ID    string
+	Total int64
+}
+
+// BorderDTO merely contains "order" in its name.
+type BorderDTO struct {
+	ID    string
+	Width int64
+}
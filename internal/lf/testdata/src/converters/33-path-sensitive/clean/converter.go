@@ -0,0 +1,87 @@
+package sample_path_sensitive_clean
+
+import (
+	"errors"
+
+	models "converters/33-path-sensitive/models"
+)
+
+var errNoID = errors.New("no id")
+
+func validate(u models.User) error {
+	if u.ID == "" {
+		return errNoID
+	}
+	return nil
+}
+
+// ErrorToDTO returns a zero output with the error: that path yields no output.
+func ErrorToDTO(u models.User) (models.UserDTO, error) {
+	if u.ID == "" {
+		return models.UserDTO{}, errNoID
+	}
+	return models.UserDTO{ID: u.ID, Name: u.Name, Email: u.Email}, nil
+}
+
+// PartialErrorToDTO returns what it has alongside a non-nil error; still an error path.
+func PartialErrorToDTO(u models.User) (models.UserDTO, error) {
+	if err := validate(u); err != nil {
+		return models.UserDTO{Name: u.Name}, err
+	}
+	return models.UserDTO{ID: u.ID, Name: u.Name, Email: u.Email}, nil
+}
+
+// NilToDTO returns nil for a nil input.
+func NilToDTO(u *models.User) *models.UserDTO {
+	if u == nil {
+		return nil
+	}
+	return &models.UserDTO{ID: u.ID, Name: u.Name, Email: u.Email}
+}
+
+// BothBranchesToDTO sets Name on either side of the if: it is set on every path.
+func BothBranchesToDTO(u models.User) models.UserDTO {
+	out := models.UserDTO{ID: u.ID}
+	if u.Name != "" {
+		out.Name = u.Name
+	} else {
+		out.Name = "anonymous"
+	}
+	out.Email = u.Email
+	return out
+}
+
+// NamedErrorToDTO leaves through bare returns on its error paths.
+func NamedErrorToDTO(u models.User) (dto models.UserDTO, err error) {
+	if u.ID == "" {
+		err = errNoID
+		return
+	}
+	if err = validate(u); err != nil {
+		return
+	}
+	dto.ID = u.ID
+	dto.Name = u.Name
+	dto.Email = u.Email
+	return
+}
+
+// PanicToDTO panics instead of returning a partial output.
+func PanicToDTO(u models.User) models.UserDTO {
+	if u.ID == "" {
+		panic("no id")
+	}
+	return models.UserDTO{ID: u.ID, Name: u.Name, Email: u.Email}
+}
+
+// ShadowedToDTO declares another output in a nested block: that one is not reset
+// into the converter's output.
+func ShadowedToDTO(u models.User) models.UserDTO {
+	out := models.UserDTO{ID: u.ID, Name: u.Name}
+	if u.Email == "" {
+		out := models.UserDTO{}
+		_ = out
+	}
+	out.Email = u.Email
+	return out
+}
@@ -0,0 +1,60 @@
+package sample_path_sensitive_dirty
+
+import (
+	models "converters/33-path-sensitive/models"
+)
+
+// BranchToDTO returns a full literal on one branch and a half-filled one on the other.
+// Merged over the body every field is set; the early return still loses Email.
+func BranchToDTO(u models.User) models.UserDTO {
+	if u.Email == "" {
+		return models.UserDTO{ID: u.ID, Name: u.Name} // want "BranchToDTO"
+	}
+	return models.UserDTO{ID: u.ID, Name: u.Name, Email: u.Email}
+}
+
+// VarToDTO fills a local on two paths that each set a different field.
+func VarToDTO(u *models.User) *models.UserDTO {
+	out := &models.UserDTO{ID: u.ID}
+	if u.Name != "" {
+		out.Name = u.Name
+		return out // want "VarToDTO"
+	}
+	out.Email = u.Email
+	return out // want "VarToDTO"
+}
+
+// NamedToDTO sets Email on one side of the if only.
+func NamedToDTO(u models.User) (dto models.UserDTO, err error) {
+	dto.ID = u.ID
+	dto.Name = u.Name
+	if u.Email != "" {
+		dto.Email = u.Email
+	}
+	return // want "NamedToDTO"
+}
+
+// MissingEverywhereToDTO never sets Email: that is the converter's finding, and the
+// half-filled branch adds Name on top of it.
+func MissingEverywhereToDTO(u models.User) models.UserDTO { // want "MissingEverywhereToDTO" MissingEverywhereToDTO:"incompleteConverter"
+	if u.Name == "" {
+		return models.UserDTO{ID: u.ID} // want "MissingEverywhereToDTO"
+	}
+	return models.UserDTO{ID: u.ID, Name: u.Name}
+}
+
+func check(u models.User) error {
+	return nil
+}
+
+// ErrToDTO returns the error of check on its early return, which is nil on success:
+// that return yields the half-filled output.
+func ErrToDTO(u models.User) (models.UserDTO, error) {
+	out := models.UserDTO{ID: u.ID, Name: u.Name}
+	err := check(u)
+	if u.Email == "" {
+		return out, err // want "ErrToDTO"
+	}
+	out.Email = u.Email
+	return out, err
+}
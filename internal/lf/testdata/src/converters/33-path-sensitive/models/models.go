@@ -0,0 +1,13 @@
+package models
+
+type User struct {
+	ID    string
+	Name  string
+	Email string
+}
+
+type UserDTO struct {
+	ID    string
+	Name  string
+	Email string
+}
@@ -0,0 +1,19 @@
+package sample_setters_builder
+
+import (
+	models "converters/34-setters/models"
+)
+
+// ToBuilder chains every field off the builder.
+func ToBuilder(u models.User) *models.UserBuilder {
+	b := &models.UserBuilder{}
+	b.WithID(u.ID).WithName(u.Name).WithEmail(u.Email)
+	return b
+}
+
+// ToBuilderMissing drops the email from the chain.
+func ToBuilderMissing(u models.User) *models.UserBuilder { // want "ToBuilderMissing" ToBuilderMissing:"incompleteConverter"
+	b := &models.UserBuilder{}
+	b.WithID(u.ID).WithName(u.Name)
+	return b
+}
@@ -0,0 +1,35 @@
+package models
+
+type User struct {
+	ID    string
+	Name  string
+	Email string
+
+	pending []string
+}
+
+// UserPB stands in for a protobuf message filled through its setters.
+type UserPB struct {
+	ID    string
+	Name  string
+	Email string
+}
+
+func (m *UserPB) SetID(v string)    { m.ID = v }
+func (m *UserPB) SetName(v string)  { m.Name = v }
+func (m *UserPB) SetEmail(v string) { m.Email = v }
+func (m *UserPB) ClearEmail()       { m.Email = "" }
+
+// UserBuilder is filled through chained With* calls.
+type UserBuilder struct {
+	ID    string
+	Name  string
+	Email string
+}
+
+func (b *UserBuilder) WithID(v string) *UserBuilder    { b.ID = v; return b }
+func (b *UserBuilder) WithName(v string) *UserBuilder  { b.Name = v; return b }
+func (b *UserBuilder) WithEmail(v string) *UserBuilder { b.Email = v; return b }
+
+// SetEmail queues a change of the user's email.
+func (u *User) SetEmail(v string) { u.pending = append(u.pending, "email="+v) }
@@ -0,0 +1,49 @@
+package sample_setters
+
+import (
+	models "converters/34-setters/models"
+)
+
+// ToPB sets every field through its setter.
+func ToPB(u models.User) *models.UserPB {
+	out := &models.UserPB{}
+	out.SetID(u.ID)
+	out.SetName(u.Name)
+	out.SetEmail(u.Email)
+	return out
+}
+
+// ToPBClearing clears the email instead of setting it when there is none.
+func ToPBClearing(u models.User) *models.UserPB {
+	out := &models.UserPB{}
+	out.SetID(u.ID)
+	out.SetName(u.Name)
+	if u.Email == "" {
+		out.ClearEmail()
+	}
+	return out
+}
+
+// ToPBMissing never sets the email.
+func ToPBMissing(u models.User) *models.UserPB { // want "ToPBMissing" ToPBMissing:"incompleteConverter"
+	out := &models.UserPB{}
+	out.SetID(u.ID)
+	out.SetName(u.Name)
+	return out
+}
+
+// fillPB writes its output through setters only: dst is still the output.
+func fillPB(dst *models.UserPB, u models.User) { // want "fillPB"
+	dst.SetID(u.ID)
+	dst.SetName(u.Name)
+}
+
+// ToPBInputSetter calls a setter on its input: that writes Email, it does not read it.
+func ToPBInputSetter(u models.User) *models.UserPB { // want "ToPBInputSetter" ToPBInputSetter:"incompleteConverter"
+	u.SetEmail("")
+	out := &models.UserPB{}
+	out.SetID(u.ID)
+	out.SetName(u.Name)
+	out.SetEmail("redacted")
+	return out
+}
@@ -0,0 +1,39 @@
+package sample_helper_calls
+
+import (
+	models "converters/35-helper-calls/models"
+)
+
+// ToDTO splits the work between helpers: fillAddress writes through the output pointer,
+// buildMeta and describe read the input.
+func ToDTO(u models.User) models.UserDTO {
+	out := models.UserDTO{}
+	out.ID = u.ID
+	out.Name = u.Name
+	fillAddress(&out, u.Address)
+	out.Meta = buildMeta(u)
+	out.Description = describe(u, 2)
+	return out
+}
+
+func fillAddress(dst *models.UserDTO, a models.Address) {
+	dst.Street = a.Street
+	setCity(dst, a.City)
+}
+
+// setCity is a helper's helper.
+func setCity(dst *models.UserDTO, city string) {
+	dst.City = city
+}
+
+func buildMeta(u models.User) models.Meta {
+	return models.Meta{CreatedBy: u.CreatedBy, Source: u.Source}
+}
+
+// describe is recursive; its summary stops at the cycle.
+func describe(u models.User, depth int) string {
+	if depth == 0 {
+		return u.Nickname
+	}
+	return describe(u, depth-1) + u.Bio
+}
@@ -0,0 +1,24 @@
+package sample_helper_calls
+
+import (
+	models "converters/35-helper-calls/models"
+)
+
+// ToDTOMissing relies on a helper that never sets City and never reads the bio.
+func ToDTOMissing(u models.User) models.UserDTO { // want "ToDTOMissing" ToDTOMissing:"incompleteConverter"
+	out := models.UserDTO{}
+	out.ID = u.ID
+	out.Name = u.Name
+	fillStreet(&out, u.Address)
+	out.Meta = buildMeta(u)
+	out.Description = u.Nickname
+	return out
+}
+
+func fillStreet(dst *models.UserDTO, a models.Address) {
+	dst.Street = a.Street
+}
+
+func buildMeta(u models.User) models.Meta {
+	return models.Meta{CreatedBy: u.CreatedBy, Source: u.Source}
+}
@@ -0,0 +1,30 @@
+package models
+
+type Address struct {
+	Street string
+	City   string
+}
+
+type Meta struct {
+	CreatedBy string
+	Source    string
+}
+
+type User struct {
+	ID        string
+	Name      string
+	Address   Address
+	CreatedBy string
+	Source    string
+	Nickname  string
+	Bio       string
+}
+
+type UserDTO struct {
+	ID          string
+	Name        string
+	Street      string
+	City        string
+	Meta        Meta
+	Description string
+}
@@ -0,0 +1,17 @@
+package sample_facts
+
+import (
+	"converters/36-facts/mapping"
+	models "converters/36-facts/models"
+)
+
+// ToDTO leaves the work to the helpers of another package.
+func ToDTO(u models.User) models.UserDTO {
+	out := models.UserDTO{}
+	out.ID = u.ID
+	out.Name = u.Name
+	mapping.FillAddress(&out, u.Address)
+	out.Meta = mapping.BuildMeta(u)
+	out.Description = mapping.Describe(u)
+	return out
+}
@@ -0,0 +1,17 @@
+package sample_facts
+
+import (
+	"converters/36-facts/mapping"
+	models "converters/36-facts/models"
+)
+
+// ToDTOMissing uses a helper that never sets City and never describes the user.
+func ToDTOMissing(u models.User) models.UserDTO { // want "ToDTOMissing" ToDTOMissing:"incompleteConverter"
+	out := models.UserDTO{}
+	out.ID = u.ID
+	out.Name = u.Name
+	mapping.FillStreet(&out, u.Address)
+	out.Meta = mapping.BuildMeta(u)
+	out.Description = u.Nickname
+	return out
+}
@@ -0,0 +1,33 @@
+// Package mapping holds the helpers the converters of clean and dirty share. Its
+// exported helpers carry what they map to their callers as facts.
+package mapping
+
+import (
+	models "converters/36-facts/models"
+)
+
+func FillAddress(dst *models.UserDTO, a models.Address) { // want FillAddress:`fieldUsage\(dst: City, Street; a: City, Street\)`
+	dst.Street = a.Street
+	setCity(dst, a.City)
+}
+
+func setCity(dst *models.UserDTO, city string) {
+	dst.City = city
+}
+
+func FillStreet(dst *models.UserDTO, a models.Address) { // want FillStreet:`fieldUsage\(dst: Street; a: Street\)`
+	dst.Street = a.Street
+}
+
+func BuildMeta(u models.User) models.Meta { // want BuildMeta:`fieldUsage\(u: CreatedBy, Source\)`
+	return models.Meta{CreatedBy: u.CreatedBy, Source: u.Source}
+}
+
+// Describe reaches the bio through a helper of its own.
+func Describe(u models.User) string { // want Describe:`fieldUsage\(u: Bio, Nickname\)`
+	return u.Nickname + bio(u)
+}
+
+func bio(u models.User) string {
+	return u.Bio
+}
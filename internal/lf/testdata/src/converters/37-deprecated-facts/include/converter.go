@@ -0,0 +1,31 @@
+package sample_deprecated_facts_include
+
+import (
+	models "converters/37-deprecated-facts/models"
+	"converters/37-deprecated-facts/pb"
+)
+
+// EventToReply skips a field the models package marks deprecated.
+func EventToReply(e models.Event) models.EventReply { // want "EventToReply" EventToReply:"incompleteConverter"
+	return models.EventReply{
+		ID:   e.ID,
+		Name: e.Name,
+	}
+}
+
+// EventFromPB skips a field deprecated in the .proto file.
+func EventFromPB(in *pb.EventPB) models.Event { // want "EventFromPB" EventFromPB:"incompleteConverter"
+	return models.Event{
+		ID:   in.Id,
+		Name: in.Name,
+	}
+}
+
+// EventPage skips the deprecated field of a generic type.
+func EventPage(in models.Page[models.Event]) models.Page[models.EventReply] { // want "EventPage" EventPage:"incompleteConverter"
+	out := models.Page[models.EventReply]{}
+	for _, e := range in.Items {
+		out.Items = append(out.Items, EventToReply(e))
+	}
+	return out
+}
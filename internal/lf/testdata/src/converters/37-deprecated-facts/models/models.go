@@ -0,0 +1,22 @@
+package models
+
+type Event struct {
+	ID string
+	// Deprecated: use Name instead
+	OldName string
+	Name    string
+}
+
+type EventReply struct {
+	ID string
+	// Deprecated: use Name instead
+	OldName string
+	Name    string
+}
+
+// Page is generic: its fields are deprecated for every instantiation.
+type Page[T any] struct {
+	Items []T
+	// Deprecated: use Items instead
+	Rows []T
+}
@@ -0,0 +1,26 @@
+package sample_conversion
+
+import (
+	models "converters/38-copies/models"
+)
+
+func RecordToUser(r models.UserRecord) models.User {
+	return models.User(r)
+}
+
+func RecordPtrToUser(r *models.UserRecord) *models.User {
+	return (*models.User)(r)
+}
+
+func FillUser(dst *models.User, r *models.UserRecord) {
+	*dst = models.User(*r)
+}
+
+// AccountToDTO converts between structs differing only in their tags.
+func AccountToDTO(a models.Account) models.AccountDTO {
+	return models.AccountDTO(a)
+}
+
+func AccountPtrToDTO(a *models.Account) *models.AccountDTO {
+	return (*models.AccountDTO)(a)
+}
@@ -0,0 +1,32 @@
+package sample_copies
+
+import (
+	models "converters/38-copies/models"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/jinzhu/copier"
+)
+
+// ToDTO copies by name: Login has no counterpart in UserDTO, nor Username in User.
+func ToDTO(u models.User) (models.UserDTO, error) { // want "ToDTO: unchecked reflective copy" ToDTO:"incompleteConverter"
+	var out models.UserDTO
+	err := copier.Copy(&out, &u)
+	return out, err
+}
+
+// ToDTOMapped copies what pairs by name and maps the rest by hand.
+func ToDTOMapped(u *models.User) (*models.UserDTO, error) {
+	out := &models.UserDTO{}
+	if err := copier.Copy(out, u); err != nil {
+		return nil, err
+	}
+	out.Username = u.Login
+	return out, nil
+}
+
+// ToRow pairs ID with Id.
+func ToRow(u models.User) (models.UserRow, error) {
+	var out models.UserRow
+	err := mapstructure.Decode(u, &out)
+	return out, err
+}
@@ -0,0 +1,43 @@
+package models
+
+type User struct {
+	ID    string
+	Name  string
+	Email string
+	Login string
+}
+
+// UserRecord has the fields of User, so the two convert into each other.
+type UserRecord struct {
+	ID    string
+	Name  string
+	Email string
+	Login string
+}
+
+type UserDTO struct {
+	ID       string
+	Name     string
+	Email    string
+	Username string
+}
+
+// UserRow spells ID differently; copy libraries pair names case-insensitively.
+type UserRow struct {
+	Id    string
+	Name  string
+	Email string
+	Login string
+}
+
+// Account and AccountDTO have the same fields under different tags; Go converts
+// between them all the same.
+type Account struct {
+	ID    string `db:"id"`
+	Owner string `db:"owner"`
+}
+
+type AccountDTO struct {
+	ID    string `json:"id"`
+	Owner string `json:"owner"`
+}
@@ -0,0 +1,36 @@
+package sample_embedded
+
+import (
+	models "converters/39-embedded/models"
+)
+
+// ToRow sets the promoted fields one by one.
+func ToRow(u models.User) models.UserRow {
+	out := models.UserRow{Name: u.Name, CreatedBy: u.CreatedBy, Version: u.Version}
+	out.ID = u.ID
+	out.CreatedAt = u.CreatedAt
+	out.UpdatedAt = u.UpdatedAt
+	out.DeletedAt = u.DeletedAt
+	return out
+}
+
+// ToRecord mixes promoted access with access through the embeds.
+func ToRecord(u models.User) models.UserRecord {
+	out := models.UserRecord{Name: u.Name, CreatedBy: u.CreatedBy}
+	out.Base.Version = u.Version
+	out.GormModel.ID = u.ID
+	out.Base.GormModel.CreatedAt = u.CreatedAt
+	out.UpdatedAt = u.UpdatedAt
+	out.DeletedAt = u.DeletedAt
+	return out
+}
+
+// ToRowWhole sets the embed whole.
+func ToRowWhole(u models.User) models.UserRow {
+	return models.UserRow{
+		GormModel: models.GormModel{ID: u.ID, CreatedAt: u.CreatedAt, UpdatedAt: u.UpdatedAt, DeletedAt: u.DeletedAt},
+		Name:      u.Name,
+		CreatedBy: u.CreatedBy,
+		Version:   u.Version,
+	}
+}
@@ -0,0 +1,22 @@
+package sample_embedded
+
+import (
+	models "converters/39-embedded/models"
+)
+
+// ToRowMissing forgets the soft-delete timestamp.
+func ToRowMissing(u models.User) models.UserRow { // want "ToRowMissing" ToRowMissing:"incompleteConverter"
+	out := models.UserRow{Name: u.Name, CreatedBy: u.CreatedBy, Version: u.Version}
+	out.ID = u.ID
+	out.CreatedAt = u.CreatedAt
+	out.UpdatedAt = u.UpdatedAt
+	return out
+}
+
+// ToRecordMissing forgets the timestamps two embeds deep and the audit trail.
+func ToRecordMissing(u models.User) models.UserRecord { // want "ToRecordMissing" ToRecordMissing:"incompleteConverter"
+	out := models.UserRecord{Name: u.Name}
+	out.ID = u.ID
+	out.Version = u.Version
+	return out
+}
@@ -0,0 +1,46 @@
+package models
+
+import "time"
+
+type GormModel struct {
+	ID        uint
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+type Audit struct {
+	CreatedBy string
+	// Deprecated: use CreatedBy
+	Author string
+}
+
+// User embeds its audit trail through a pointer.
+type User struct {
+	*Audit
+	ID        uint
+	Name      string
+	Version   int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+type UserRow struct {
+	GormModel
+	Name      string
+	CreatedBy string
+	Version   int
+}
+
+// Base embeds GormModel, so UserRecord gets its fields two embeds deep.
+type Base struct {
+	GormModel
+	Version int
+}
+
+type UserRecord struct {
+	Base
+	Name      string
+	CreatedBy string
+}
@@ -0,0 +1,44 @@
+package clean
+
+import "converters/40-nested-depth/models"
+
+// Nested structs handed to converters whole are complete at any depth, nil-checked or not.
+func ConvertEvent(e models.Event) models.EventDTO {
+	out := models.EventDTO{ID: e.ID}
+	out.Host = ConvertUser(e.Host)
+	if e.Owner != nil {
+		out.Owner = ConvertUserPtr(e.Owner)
+	}
+	return out
+}
+
+// Role is assigned wholesale through a conversion.
+func ConvertRoleHolder(u models.User) models.UserDTO {
+	return models.UserDTO{
+		ID:      u.ID,
+		Name:    u.Name,
+		Role:    models.RoleDTO(u.Role),
+		Manager: ConvertUserPtr(u.Manager),
+	}
+}
+
+func ConvertUser(u models.User) models.UserDTO {
+	return models.UserDTO{
+		ID:      u.ID,
+		Name:    u.Name,
+		Role:    models.RoleDTO(u.Role),
+		Manager: ConvertUserPtr(u.Manager),
+	}
+}
+
+func ConvertUserPtr(u *models.User) *models.UserDTO {
+	if u == nil {
+		return nil
+	}
+	return &models.UserDTO{
+		ID:      u.ID,
+		Name:    u.Name,
+		Role:    models.RoleDTO(u.Role),
+		Manager: ConvertUserPtr(u.Manager),
+	}
+}
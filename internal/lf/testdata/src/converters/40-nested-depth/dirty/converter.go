@@ -0,0 +1,50 @@
+package dirty
+
+import "converters/40-nested-depth/models"
+
+// Owner is only nil-checked on the input and built empty on the output.
+func ConvertEvent(e models.Event) models.EventDTO { // want "incomplete converter with missing fields: e.Owner.ID" ConvertEvent:"incompleteConverter"
+	out := models.EventDTO{ID: e.ID, Host: ConvertUser(e.Host)}
+	if e.Owner != nil {
+		out.Owner = &models.UserDTO{}
+	}
+	return out
+}
+
+// Host is mapped through chains; its Manager, two levels down, is built empty.
+func ConvertEventHost(e models.Event) models.EventDTO {
+	out := models.EventDTO{
+		ID: e.ID,
+		Host: models.UserDTO{
+			ID:   e.Host.ID,
+			Name: e.Host.Name,
+			Role: models.RoleDTO(e.Host.Role),
+		},
+		Owner: ConvertUserPtr(e.Owner),
+	}
+	if e.Host.Manager != nil {
+		out.Host.Manager = &models.UserDTO{}
+	}
+	return out
+}
+
+func ConvertUser(u models.User) models.UserDTO {
+	return models.UserDTO{
+		ID:      u.ID,
+		Name:    u.Name,
+		Role:    models.RoleDTO(u.Role),
+		Manager: ConvertUserPtr(u.Manager),
+	}
+}
+
+func ConvertUserPtr(u *models.User) *models.UserDTO {
+	if u == nil {
+		return nil
+	}
+	return &models.UserDTO{
+		ID:      u.ID,
+		Name:    u.Name,
+		Role:    models.RoleDTO(u.Role),
+		Manager: ConvertUserPtr(u.Manager),
+	}
+}
@@ -0,0 +1,38 @@
+package models
+
+type Role struct {
+	ID   string
+	Name string
+}
+
+// User refers to itself through Manager.
+type User struct {
+	ID      string
+	Name    string
+	Role    Role
+	Manager *User
+}
+
+type Event struct {
+	ID    string
+	Host  User
+	Owner *User
+}
+
+type RoleDTO struct {
+	ID   string
+	Name string
+}
+
+type UserDTO struct {
+	ID      string
+	Name    string
+	Role    RoleDTO
+	Manager *UserDTO
+}
+
+type EventDTO struct {
+	ID    string
+	Host  UserDTO
+	Owner *UserDTO
+}
@@ -0,0 +1,34 @@
+package shallow
+
+import "converters/40-nested-depth/models"
+
+// Without nested-depth, a nested struct with no chain into it counts as handled: Owner
+// is only nil-checked on the input and built empty on the output.
+func ConvertEvent(e models.Event) models.EventDTO {
+	out := models.EventDTO{ID: e.ID, Host: ConvertUser(e.Host)}
+	if e.Owner != nil {
+		out.Owner = &models.UserDTO{}
+	}
+	return out
+}
+
+func ConvertUser(u models.User) models.UserDTO {
+	return models.UserDTO{
+		ID:      u.ID,
+		Name:    u.Name,
+		Role:    models.RoleDTO(u.Role),
+		Manager: ConvertUserPtr(u.Manager),
+	}
+}
+
+func ConvertUserPtr(u *models.User) *models.UserDTO {
+	if u == nil {
+		return nil
+	}
+	return &models.UserDTO{
+		ID:      u.ID,
+		Name:    u.Name,
+		Role:    models.RoleDTO(u.Role),
+		Manager: ConvertUserPtr(u.Manager),
+	}
+}
@@ -0,0 +1,69 @@
+package sample_nested_calls
+
+import (
+	"converters/41-nested-calls/mapping"
+	models "converters/41-nested-calls/models"
+)
+
+func toGroupDTO(g models.Group) models.GroupDTO {
+	return models.GroupDTO{ID: g.ID, Title: g.Title}
+}
+
+// ConvertUser fills every nested struct with a complete converter, of this package or
+// another one.
+func ConvertUser(u models.User) models.UserDTO {
+	return models.UserDTO{
+		ID:      u.ID,
+		Name:    u.Name,
+		Role:    mapping.ToRoleDTO(u.Role),
+		Group:   toGroupDTO(u.Group),
+		Address: mapping.ToAddressDTO(u.Address),
+	}
+}
+
+// ConvertUserConstructed fills the address with a constructor reading all of it.
+func ConvertUserConstructed(u models.User) models.UserDTO {
+	return models.UserDTO{
+		ID:      u.ID,
+		Name:    u.Name,
+		Role:    mapping.ToRoleDTO(u.Role),
+		Group:   toGroupDTO(u.Group),
+		Address: mapping.NewAddressDTO(u.Address),
+	}
+}
+
+// ConvertUserFields fills the nested structs field by field.
+func ConvertUserFields(u *models.User) *models.UserDTO {
+	out := &models.UserDTO{}
+	out.ID = u.ID
+	out.Name = u.Name
+	out.Role = mapping.ToRoleDTO(u.Role)
+	out.Group = toGroupDTO(u.Group)
+	out.Address = mapping.ToAddressDTO(u.Address)
+	return out
+}
+
+// ToCategoryDTO converts the parent with itself.
+func ToCategoryDTO(c *models.Category) *models.CategoryDTO {
+	if c == nil {
+		return nil
+	}
+	return &models.CategoryDTO{ID: c.ID, Name: c.Name, Parent: ToCategoryDTO(c.Parent)}
+}
+
+// toRoleDTOIgnored is known to be incomplete and says so: its callers do not inherit it.
+//
+//lostfield:ignore the name is localized elsewhere
+func toRoleDTOIgnored(r models.Role) models.RoleDTO {
+	return models.RoleDTO{ID: r.ID}
+}
+
+func ConvertUserLocalizedRole(u models.User) models.UserDTO {
+	return models.UserDTO{
+		ID:      u.ID,
+		Name:    u.Name,
+		Role:    toRoleDTOIgnored(u.Role),
+		Group:   toGroupDTO(u.Group),
+		Address: mapping.ToAddressDTO(u.Address),
+	}
+}
@@ -0,0 +1,59 @@
+package sample_nested_calls
+
+import (
+	"converters/41-nested-calls/mapping"
+	models "converters/41-nested-calls/models"
+)
+
+func toRoleDTO(r models.Role) models.RoleDTO { // want "toRoleDTO: incomplete converter with missing fields: r.Name, Name"
+	return models.RoleDTO{ID: r.ID}
+}
+
+// NewGroupDTO is no converter, only a constructor of the output.
+func NewGroupDTO(g models.Group) models.GroupDTO { // want NewGroupDTO:`fieldUsage\(g: ID, Title\)`
+	return models.GroupDTO{ID: g.ID, Title: g.Title}
+}
+
+// ConvertUser leaves the name out, and fills the nested structs with an incomplete
+// converter of this package, one of another package and a constructor leaving a field
+// out: its diagnostic points at them.
+func ConvertUser(u models.User) models.UserDTO { // want `ConvertUser: incomplete converter \(Role via toRoleDTO: incomplete converter, Group via mapping.ToGroupDTO: incomplete converter, Address via mapping.NewStreetAddressDTO: not a converter\) with missing fields: u.Name, Name` ConvertUser:"incompleteConverter"
+	return models.UserDTO{
+		ID:      u.ID,
+		Role:    toRoleDTO(u.Role),
+		Group:   mapping.ToGroupDTO(u.Group),
+		Address: mapping.NewStreetAddressDTO(u.Address),
+	}
+}
+
+// ConvertUserFields fills the nested structs field by field, and leaves the name out.
+// NewGroupDTO reads the whole group: it is not pointed at.
+func ConvertUserFields(u *models.User) *models.UserDTO { // want `ConvertUserFields: incomplete converter \(Role via toRoleDTO: incomplete converter\) with missing fields: u.Name, Name` ConvertUserFields:"incompleteConverter"
+	out := &models.UserDTO{}
+	out.ID = u.ID
+	out.Role = toRoleDTO(u.Role)
+	out.Group = NewGroupDTO(u.Group)
+	out.Address = mapping.ToAddressDTO(u.Address)
+	return out
+}
+
+// ConvertUserComplete maps every field: the nested calls are not reported for it, the
+// incomplete toRoleDTO being reported on its own.
+func ConvertUserComplete(u models.User) models.UserDTO {
+	return models.UserDTO{
+		ID:      u.ID,
+		Name:    u.Name,
+		Role:    toRoleDTO(u.Role),
+		Group:   mapping.ToGroupDTO(u.Group),
+		Address: mapping.NewStreetAddressDTO(u.Address),
+	}
+}
+
+// ToCategoryDTO converts the parent with itself, and leaves the name out: that is
+// reported once, not again as an incomplete nested converter.
+func ToCategoryDTO(c *models.Category) *models.CategoryDTO { // want "ToCategoryDTO: incomplete converter with missing fields: c.Name, Name" ToCategoryDTO:"incompleteConverter"
+	if c == nil {
+		return nil
+	}
+	return &models.CategoryDTO{ID: c.ID, Parent: ToCategoryDTO(c.Parent)}
+}
@@ -0,0 +1,31 @@
+// Package mapping holds converters the converters of clean and dirty call for nested
+// structs. Its incomplete converters, and its helpers that are no converters, are known
+// to their callers through facts.
+package mapping
+
+import (
+	models "converters/41-nested-calls/models"
+)
+
+func ToRoleDTO(r models.Role) models.RoleDTO {
+	return models.RoleDTO{ID: r.ID, Name: r.Name}
+}
+
+func ToGroupDTO(g models.Group) models.GroupDTO { // want "ToGroupDTO: incomplete converter with missing fields: g.Title, Title" ToGroupDTO:`incompleteConverter\(g.Title, Title\)`
+	return models.GroupDTO{ID: g.ID}
+}
+
+func ToAddressDTO(a models.Address) models.AddressDTO {
+	return models.AddressDTO{Street: a.Street, City: a.City}
+}
+
+// NewAddressDTO is a constructor, not a converter, reading every field of the address.
+func NewAddressDTO(a models.Address) models.AddressDTO { // want NewAddressDTO:`fieldUsage\(a: City, Street\)`
+	return models.AddressDTO{Street: a.Street, City: a.City}
+}
+
+// NewStreetAddressDTO is a constructor too, which leaves the city out: nothing checks
+// what it maps.
+func NewStreetAddressDTO(a models.Address) models.AddressDTO { // want NewStreetAddressDTO:`fieldUsage\(a: Street\)`
+	return models.AddressDTO{Street: a.Street}
+}
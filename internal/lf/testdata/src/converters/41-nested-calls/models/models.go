@@ -0,0 +1,60 @@
+package models
+
+type Role struct {
+	ID   int64
+	Name string
+}
+
+type RoleDTO struct {
+	ID   int64
+	Name string
+}
+
+type Group struct {
+	ID    int64
+	Title string
+}
+
+type GroupDTO struct {
+	ID    int64
+	Title string
+}
+
+type Address struct {
+	Street string
+	City   string
+}
+
+type AddressDTO struct {
+	Street string
+	City   string
+}
+
+type User struct {
+	ID      int64
+	Name    string
+	Role    Role
+	Group   Group
+	Address Address
+}
+
+type UserDTO struct {
+	ID      int64
+	Name    string
+	Role    RoleDTO
+	Group   GroupDTO
+	Address AddressDTO
+}
+
+// Category refers to itself: its converter converts the parent with itself.
+type Category struct {
+	ID     int64
+	Name   string
+	Parent *Category
+}
+
+type CategoryDTO struct {
+	ID     int64
+	Name   string
+	Parent *CategoryDTO
+}
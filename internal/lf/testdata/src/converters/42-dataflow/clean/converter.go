@@ -0,0 +1,68 @@
+package sample_dataflow
+
+import (
+	"strings"
+
+	models "converters/42-dataflow/models"
+)
+
+func normalize(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func status(active bool) string {
+	if active {
+		return "active"
+	}
+	return "inactive"
+}
+
+func toRoleDTO(r models.Role) models.RoleDTO {
+	return models.RoleDTO{ID: r.ID, Name: r.Name}
+}
+
+// ToUserDTO moves every value through local temporaries and helpers.
+func ToUserDTO(u models.User) models.UserDTO {
+	name := strings.TrimSpace(u.Name)
+	var role models.RoleDTO
+	role.ID = u.Role.ID
+	role.Name = u.Role.Name
+	out := models.UserDTO{ID: u.ID, Name: name}
+	out.Email = normalize(u.Email)
+	out.Status = status(u.Active)
+	out.Role = role
+	return out
+}
+
+// ToUserDTOBranch chooses the status by a branch on the input.
+func ToUserDTOBranch(u *models.User) *models.UserDTO {
+	if u == nil {
+		return nil
+	}
+	out := &models.UserDTO{ID: u.ID, Name: u.Name, Email: u.Email, Role: toRoleDTO(u.Role)}
+	out.Status = "inactive"
+	if u.Active {
+		out.Status = "active"
+	}
+	return out
+}
+
+// FillUserDTO fills its output through a pointer.
+func FillUserDTO(dst *models.UserDTO, u models.User) {
+	dst.ID = u.ID
+	dst.Name = u.Name
+	dst.Email = u.Email
+	dst.Status = status(u.Active)
+	dst.Role = toRoleDTO(u.Role)
+}
+
+func lookupStatus(id int64) string {
+	return "active"
+}
+
+// ToUserDTOStatusLookup looks the status up instead: reading the flag into the blank
+// identifier acknowledges it is not mapped.
+func ToUserDTOStatusLookup(u models.User) models.UserDTO {
+	_ = u.Active
+	return models.UserDTO{ID: u.ID, Name: u.Name, Email: u.Email, Status: lookupStatus(u.ID), Role: toRoleDTO(u.Role)}
+}
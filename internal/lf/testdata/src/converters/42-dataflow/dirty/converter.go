@@ -0,0 +1,51 @@
+package sample_dataflow
+
+import (
+	"log"
+
+	models "converters/42-dataflow/models"
+)
+
+func status(active bool) string {
+	if active {
+		return "active"
+	}
+	return "inactive"
+}
+
+// ToUserDTOPlaceholder sets a placeholder email and only logs the input's.
+func ToUserDTOPlaceholder(u models.User) models.UserDTO { // want `ToUserDTOPlaceholder: incomplete converter with fields set only from constants: Email; fields reaching no output: u.Email`
+	log.Printf("converting %s", u.Email)
+	return models.UserDTO{
+		ID:     u.ID,
+		Name:   u.Name,
+		Email:  "",
+		Status: status(u.Active),
+		Role:   models.RoleDTO{ID: u.Role.ID, Name: u.Role.Name},
+	}
+}
+
+// ToUserDTOEmptyRole logs the role instead of mapping it. The nil check does not choose
+// the zero role.
+func ToUserDTOEmptyRole(u *models.User) *models.UserDTO { // want `ToUserDTOEmptyRole: incomplete converter with fields set only from constants: Role; fields reaching no output: u.Role`
+	if u == nil {
+		return nil
+	}
+	out := &models.UserDTO{ID: u.ID, Name: u.Name, Email: u.Email}
+	out.Role = models.RoleDTO{}
+	log.Printf("role %v", u.Role)
+	if u.Active {
+		out.Status = "active"
+	}
+	return out
+}
+
+// ToUserDTOMissing leaves the status out too: it is reported as missing first.
+func ToUserDTOMissing(u models.User) (out models.UserDTO) { // want `ToUserDTOMissing: incomplete converter with missing fields: u.Active, out.Status; fields set only from constants: out.Name` ToUserDTOMissing:"incompleteConverter"
+	tmp := "unknown"
+	out.ID = u.ID
+	out.Name = tmp
+	out.Email = u.Name + " <" + u.Email + ">"
+	out.Role = models.RoleDTO{ID: u.Role.ID, Name: u.Role.Name}
+	return out
+}
@@ -0,0 +1,27 @@
+package models
+
+type Role struct {
+	ID   int64
+	Name string
+}
+
+type RoleDTO struct {
+	ID   int64
+	Name string
+}
+
+type User struct {
+	ID     int64
+	Name   string
+	Email  string
+	Active bool
+	Role   Role
+}
+
+type UserDTO struct {
+	ID     int64
+	Name   string
+	Email  string
+	Status string
+	Role   RoleDTO
+}
@@ -0,0 +1,65 @@
+package sample_mappings
+
+import (
+	models "converters/43-suspicious-mappings/models"
+)
+
+// ToPersonDTO maps every field to its own; Contact pairs with Email by its json tag.
+func ToPersonDTO(p models.Person) models.PersonDTO {
+	return models.PersonDTO{
+		ID:        p.ID,
+		FirstName: p.FirstName,
+		LastName:  p.LastName,
+		Name:      p.Name,
+		Contact:   p.Email,
+		Phone:     p.Phone,
+	}
+}
+
+// ToPersonDTOFallback takes Name from FirstName on purpose: Name itself is used too.
+func ToPersonDTOFallback(p models.Person) models.PersonDTO {
+	out := models.PersonDTO{
+		ID:        p.ID,
+		FirstName: p.FirstName,
+		LastName:  p.LastName,
+		Name:      p.FirstName,
+		Contact:   p.Email,
+		Phone:     p.Phone,
+	}
+	if p.Name != "" {
+		out.Name = p.Name
+	}
+	return out
+}
+
+// FillPersonDTO sets the fields of its output one by one.
+func FillPersonDTO(p *models.Person, out *models.PersonDTO) {
+	out.ID = p.ID
+	out.FirstName = p.FirstName
+	out.LastName = p.LastName
+	out.Name = p.Name
+	out.Contact = p.Email
+	out.Phone = p.Phone
+}
+
+func reversed(p models.Person) models.Person {
+	return models.Person{ID: p.ID, FirstName: p.LastName, LastName: p.FirstName}
+}
+
+// ToPersonDTOReversed crosses the names of another person, reversed on purpose: the
+// input p is shadowed there.
+func ToPersonDTOReversed(p models.Person) models.PersonDTO {
+	out := models.PersonDTO{
+		ID:        p.ID,
+		FirstName: p.FirstName,
+		LastName:  p.LastName,
+		Name:      p.Name,
+		Contact:   p.Email,
+		Phone:     p.Phone,
+	}
+	if p.Name == "" {
+		p := reversed(p)
+		out.FirstName, out.LastName = p.LastName, p.FirstName
+	}
+	return out
+}
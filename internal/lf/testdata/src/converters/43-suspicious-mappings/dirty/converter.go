@@ -0,0 +1,39 @@
+package sample_mappings
+
+import (
+	models "converters/43-suspicious-mappings/models"
+)
+
+// ToPersonDTOSwapped swaps the first and last names.
+func ToPersonDTOSwapped(p models.Person) models.PersonDTO {
+	return models.PersonDTO{
+		ID:        p.ID,
+		FirstName: p.LastName, // want `ToPersonDTOSwapped: suspicious mapping: FirstName and LastName look swapped \(FirstName from p.LastName, LastName from p.FirstName\)`
+		LastName:  p.FirstName,
+		Name:      p.Name,
+		Contact:   p.Email,
+		Phone:     p.Phone,
+	}
+}
+
+// FillPersonDTOSwapped swaps the phone and the email, paired with Contact by its json tag.
+func FillPersonDTOSwapped(p *models.Person, out *models.PersonDTO) {
+	out.ID = p.ID
+	out.FirstName = p.FirstName
+	out.LastName = p.LastName
+	out.Name = p.Name
+	out.Phone = p.Email // want `FillPersonDTOSwapped: suspicious mapping: out.Phone and out.Contact look swapped \(out.Phone from p.Email, out.Contact from p.Phone\)`
+	out.Contact = p.Phone
+}
+
+// ToPersonDTOMismatch fills Contact from Name and leaves Email unused.
+func ToPersonDTOMismatch(p models.Person) models.PersonDTO { // want `ToPersonDTOMismatch: incomplete converter with missing fields: p.Email` ToPersonDTOMismatch:"incompleteConverter"
+	return models.PersonDTO{
+		ID:        p.ID,
+		FirstName: p.FirstName,
+		LastName:  p.LastName,
+		Name:      p.Name,
+		Contact:   p.Name, // want `ToPersonDTOMismatch: suspicious mapping: Contact set from p.Name while p.Email is not used`
+		Phone:     p.Phone,
+	}
+}
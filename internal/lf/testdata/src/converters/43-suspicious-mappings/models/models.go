@@ -0,0 +1,19 @@
+package models
+
+type Person struct {
+	ID        int64
+	FirstName string
+	LastName  string
+	Name      string
+	Email     string `json:"email"`
+	Phone     string `json:"phone"`
+}
+
+type PersonDTO struct {
+	ID        int64
+	FirstName string
+	LastName  string
+	Name      string
+	Contact   string `json:"email"`
+	Phone     string `json:"phone"`
+}
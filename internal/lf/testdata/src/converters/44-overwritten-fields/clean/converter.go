@@ -0,0 +1,70 @@
+package sample_overwrites
+
+import (
+	"strings"
+
+	models "converters/44-overwritten-fields/models"
+)
+
+func normalize(dto *models.OrderDTO) {
+	dto.Name = strings.TrimSpace(dto.Name)
+}
+
+// ToOrderDTO sets a default status, replaced under a condition only.
+func ToOrderDTO(o models.Order) models.OrderDTO {
+	out := models.OrderDTO{
+		ID:     o.ID,
+		Name:   o.Name,
+		Email:  o.Email,
+		Status: "new",
+		Count:  len(o.Items),
+	}
+	if o.Status != "" {
+		out.Status = o.Status
+	}
+	return out
+}
+
+// ToOrderDTORead reads each field back before assigning it again.
+func ToOrderDTORead(o models.Order) models.OrderDTO {
+	out := models.OrderDTO{}
+	out.ID = o.ID
+	out.Name = o.Name
+	out.Name = strings.ToUpper(out.Name)
+	out.Email = o.Email
+	out.Status = o.Status
+	normalize(&out)
+	out.Status = strings.ToLower(o.Status)
+	out.Count = 0
+	for range o.Items {
+		out.Count++
+	}
+	return out
+}
+
+// ToOrderDTOSwitch assigns the status once on each path.
+func ToOrderDTOSwitch(o models.Order) (out models.OrderDTO) {
+	out.ID = o.ID
+	out.Name = o.Name
+	out.Email = o.Email
+	out.Count = len(o.Items)
+	switch o.Status {
+	case "":
+		out.Status = "unknown"
+	default:
+		out.Status = o.Status
+	}
+	return out
+}
+
+func logName(name string) {}
+
+// ToOrderDTOAlias reads the first Name back through p before assigning it again.
+func ToOrderDTOAlias(o models.Order) models.OrderDTO {
+	out := models.OrderDTO{ID: o.ID, Email: o.Email, Status: o.Status, Count: len(o.Items)}
+	p := &out
+	out.Name = o.Name
+	logName(p.Name)
+	out.Name = strings.TrimSpace(o.Name)
+	return out
+}
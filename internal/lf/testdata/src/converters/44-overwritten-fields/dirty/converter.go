@@ -0,0 +1,79 @@
+package sample_overwrites
+
+import (
+	models "converters/44-overwritten-fields/models"
+)
+
+// ToOrderDTOCopyPaste assigns Name twice; the second line was meant for Email.
+func ToOrderDTOCopyPaste(o models.Order) models.OrderDTO { // want `ToOrderDTOCopyPaste: incomplete converter with missing fields: Email` ToOrderDTOCopyPaste:"incompleteConverter"
+	out := models.OrderDTO{}
+	out.ID = o.ID
+	out.Name = o.Name // want `ToOrderDTOCopyPaste: output field Name assigned more than once on the same path, the first value is never used`
+	out.Name = o.Email
+	out.Status = o.Status
+	out.Count = len(o.Items)
+	return out
+}
+
+// ToOrderDTOLiteral sets Status in the literal, then overwrites it unconditionally.
+func ToOrderDTOLiteral(o models.Order) models.OrderDTO {
+	out := models.OrderDTO{
+		ID:     o.ID,
+		Name:   o.Name,
+		Email:  o.Email,
+		Status: "new", // want `ToOrderDTOLiteral: output field Status assigned more than once on the same path, the first value is never used`
+		Count:  len(o.Items),
+	}
+	out.Status = o.Status
+	return out
+}
+
+// FillOrderDTO sets Status on both branches, after setting it already.
+func FillOrderDTO(o *models.Order, dst *models.OrderDTO) {
+	dst.ID = o.ID
+	dst.Name = o.Name
+	dst.Email = o.Email
+	dst.Status = o.Status // want `FillOrderDTO: output field dst.Status assigned more than once on the same path, the first value is never used`
+	if len(o.Items) > 0 {
+		dst.Status = "open"
+	} else {
+		dst.Status = "empty"
+	}
+	dst.Count = len(o.Items)
+}
+
+func draft() models.Order {
+	return models.Order{Status: "draft"}
+}
+
+// ToOrderDTOShadowed always overwrites the Status of its literal; the out of the nested
+// block is another order.
+func ToOrderDTOShadowed(o models.Order) models.OrderDTO {
+	out := models.OrderDTO{
+		ID:     o.ID,
+		Name:   o.Name,
+		Email:  o.Email,
+		Status: "new", // want `ToOrderDTOShadowed: output field Status assigned more than once on the same path, the first value is never used`
+		Count:  len(o.Items),
+	}
+	if len(o.Items) == 0 {
+		out := draft()
+		_ = out
+	}
+	out.Status = o.Status
+	return out
+}
+
+// ToOrderDTOAlias overwrites the Status of its literal through p, which shares out.
+func ToOrderDTOAlias(o models.Order) models.OrderDTO {
+	out := models.OrderDTO{
+		ID:     o.ID,
+		Name:   o.Name,
+		Email:  o.Email,
+		Status: "new", // want `ToOrderDTOAlias: output field Status assigned more than once on the same path, the first value is never used`
+		Count:  len(o.Items),
+	}
+	p := &out
+	p.Status = o.Status
+	return out
+}
@@ -0,0 +1,17 @@
+package models
+
+type Order struct {
+	ID     int64
+	Name   string
+	Email  string
+	Status string
+	Items  []string
+}
+
+type OrderDTO struct {
+	ID     int64
+	Name   string
+	Email  string
+	Status string
+	Count  int
+}
@@ -0,0 +1,37 @@
+package sample_shadowing
+
+import (
+	"strings"
+
+	models "converters/45-shadowing/models"
+)
+
+// ToUserDTOCaptured reads the input through a closure capturing it.
+func ToUserDTOCaptured(u models.User) models.UserDTO {
+	email := func() string { return strings.ToLower(u.Email) }
+	return models.UserDTO{ID: u.ID, Name: u.Name, Email: email()}
+}
+
+// ToUserDTOInnerScope declares other variables in nested scopes, under other names.
+func ToUserDTOInnerScope(u models.User) models.UserDTO {
+	out := models.UserDTO{ID: u.ID}
+	if name := strings.TrimSpace(u.Name); name != "" {
+		out.Name = name
+	}
+	for _, part := range strings.Split(u.Email, ",") {
+		out.Email = part
+		break
+	}
+	return out
+}
+
+// UserLabel only reads dst: the one it writes is its own, so dst is no output and
+// UserLabel no converter.
+func UserLabel(dst *models.UserDTO, u models.User) string { // want UserLabel:`fieldUsage\(dst: Name; u: Name\)`
+	if dst == nil {
+		dst := &models.UserDTO{}
+		dst.Name = u.Name
+		return dst.Name
+	}
+	return dst.Name
+}
@@ -0,0 +1,97 @@
+package sample_shadowing
+
+import (
+	models "converters/45-shadowing/models"
+)
+
+func anonymous() models.User {
+	return models.User{Name: "anonymous", Email: "nobody@example.com"}
+}
+
+// ToUserDTOClosure reads Email only on the parameter of a closure, another user.
+func ToUserDTOClosure(u models.User) models.UserDTO { // want `ToUserDTOClosure: incomplete converter with missing fields: u.Email` ToUserDTOClosure:"incompleteConverter"
+	emailOf := func(u models.User) string { return u.Email }
+	return models.UserDTO{ID: u.ID, Name: u.Name, Email: emailOf(anonymous())}
+}
+
+// ToUserDTOShadowed reads Email on a variable shadowing the input in a nested scope.
+func ToUserDTOShadowed(u models.User) models.UserDTO { // want `ToUserDTOShadowed: incomplete converter with missing fields: u.Email` ToUserDTOShadowed:"incompleteConverter"
+	out := models.UserDTO{ID: u.ID, Name: u.Name}
+	if u.ID == 0 {
+		u := anonymous()
+		out.Email = u.Email
+	}
+	return out
+}
+
+// ToUserDTOShadowedOutput sets Email on a variable shadowing the output.
+func ToUserDTOShadowedOutput(u models.User) models.UserDTO { // want `ToUserDTOShadowedOutput: incomplete converter with missing fields: Email` ToUserDTOShadowedOutput:"incompleteConverter"
+	out := models.UserDTO{ID: u.ID, Name: u.Name}
+	if u.Email != "" {
+		out := models.UserDTO{}
+		out.Email = u.Email
+	}
+	return out
+}
+
+func toUserDTO(u models.User) models.UserDTO {
+	return models.UserDTO{ID: u.ID, Name: u.Name, Email: u.Email}
+}
+
+// ToUserDTOForwardShadowed forwards another user whole, not its input.
+func ToUserDTOForwardShadowed(u models.User) models.UserDTO { // want `ToUserDTOForwardShadowed: incomplete converter with missing fields: u.Email, ID, Name, Email` ToUserDTOForwardShadowed:"incompleteConverter"
+	if u.ID == 0 {
+		u := anonymous()
+		return toUserDTO(u)
+	}
+	return toUserDTO(models.User{ID: u.ID, Name: u.Name})
+}
+
+// ToUserDTOConvertShadowed converts another user whole, not its input.
+func ToUserDTOConvertShadowed(u models.User) models.UserDTO { // want `ToUserDTOConvertShadowed: incomplete converter with missing fields: u.Email, Email` ToUserDTOConvertShadowed:"incompleteConverter"
+	if u.ID == 0 {
+		u := anonymous()
+		return models.UserDTO(u)
+	}
+	return models.UserDTO{ID: u.ID, Name: u.Name}
+}
+
+// ToUserDTOsRangeShadowed delegates only for other users; its input is converted inline.
+func ToUserDTOsRangeShadowed(users []models.User) []models.UserDTO { // want `ToUserDTOsRangeShadowed: incomplete converter with missing fields: users.Email, Email` ToUserDTOsRangeShadowed:"incompleteConverter"
+	out := make([]models.UserDTO, len(users))
+	for i := range users {
+		out[i] = models.UserDTO{ID: users[i].ID, Name: users[i].Name}
+	}
+	if len(out) == 0 {
+		users := []models.User{anonymous()}
+		for _, u := range users {
+			out = append(out, toUserDTO(u))
+		}
+	}
+	return out
+}
+
+// ToUserDTOGenericShadowed never uses its generic input, only another user.
+func ToUserDTOGenericShadowed[T interface{ models.User }](u T) models.UserDTO { // want `ToUserDTOGenericShadowed: incomplete converter with missing fields: u.ID, u.Name, u.Email` ToUserDTOGenericShadowed:"incompleteConverter"
+	{
+		u := anonymous()
+		return models.UserDTO{ID: u.ID, Name: u.Name, Email: u.Email}
+	}
+}
+
+// fillRest sets Email only on a copy of its own.
+func fillRest(dst *models.UserDTO, u models.User) { // want `fillRest: incomplete converter with missing fields: u.ID, dst.ID`
+	dst.Name = u.Name
+	if u.Email == "" {
+		dst := &models.UserDTO{}
+		*dst = models.UserDTO{Email: u.Email}
+		_ = dst
+	}
+}
+
+// ToUserDTOHelperShadowed leaves Email to a helper that never sets it.
+func ToUserDTOHelperShadowed(u models.User) models.UserDTO { // want `ToUserDTOHelperShadowed: incomplete converter with missing fields: Email` ToUserDTOHelperShadowed:"incompleteConverter"
+	out := models.UserDTO{ID: u.ID}
+	fillRest(&out, u)
+	return out
+}
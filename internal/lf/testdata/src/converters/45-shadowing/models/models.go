@@ -0,0 +1,13 @@
+package models
+
+type User struct {
+	ID    int64
+	Name  string
+	Email string
+}
+
+type UserDTO struct {
+	ID    int64
+	Name  string
+	Email string
+}
@@ -0,0 +1,33 @@
+package sample_aliases
+
+import (
+	models "converters/46-aliases/models"
+)
+
+// ToOrderDTO reads the input through local aliases and writes the output through
+// pointers into it.
+func ToOrderDTO(in models.Order) models.OrderDTO {
+	u := in.User
+	role := u.Role
+	out := models.OrderDTO{ID: in.ID}
+	dst := &out.User
+	dst.ID = u.ID
+	dst.Name = u.Name
+	dst.Email = u.Email
+	dst.Role = models.RoleDTO{ID: role.ID, Name: role.Name}
+	var ship = &out.Shipping
+	ship.Street = in.Shipping.Street
+	ship.City = in.Shipping.City
+	return out
+}
+
+// ToOrderDTOPointer aliases a pointer output and the fields of its input.
+func ToOrderDTOPointer(in *models.Order) *models.OrderDTO {
+	out := &models.OrderDTO{ID: in.ID}
+	addr, u := in.Shipping, in.User
+	out.Shipping = models.AddressDTO{Street: addr.Street, City: addr.City}
+	user := &out.User
+	user.ID, user.Name, user.Email = u.ID, u.Name, u.Email
+	user.Role = models.RoleDTO{ID: u.Role.ID, Name: u.Role.Name}
+	return out
+}
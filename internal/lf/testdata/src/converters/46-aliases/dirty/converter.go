@@ -0,0 +1,52 @@
+package sample_aliases
+
+import (
+	models "converters/46-aliases/models"
+)
+
+func toRoleDTO(r models.Role) models.RoleDTO {
+	return models.RoleDTO{ID: r.ID, Name: r.Name}
+}
+
+// ToOrderDTOInputAlias never reads Email off its alias of the input's user.
+func ToOrderDTOInputAlias(in models.Order) models.OrderDTO { // want `ToOrderDTOInputAlias: incomplete converter with missing fields: in.User.Email` ToOrderDTOInputAlias:"incompleteConverter"
+	u := in.User
+	return models.OrderDTO{
+		ID:       in.ID,
+		User:     models.UserDTO{ID: u.ID, Name: u.Name, Role: toRoleDTO(u.Role), Email: "hidden"},
+		Shipping: models.AddressDTO{Street: in.Shipping.Street, City: in.Shipping.City},
+	}
+}
+
+// ToUserDTONestedAlias never reads Name off its alias of the input's role.
+func ToUserDTONestedAlias(u models.User) models.UserDTO { // want `ToUserDTONestedAlias: incomplete converter with missing fields: u.Role.Name, Role.Name` ToUserDTONestedAlias:"incompleteConverter"
+	role := u.Role
+	return models.UserDTO{ID: u.ID, Name: u.Name, Email: u.Email, Role: models.RoleDTO{ID: role.ID}}
+}
+
+// ToOrderDTOOutputAlias never sets Street through its pointer into the output.
+func ToOrderDTOOutputAlias(in models.Order) models.OrderDTO { // want `ToOrderDTOOutputAlias: incomplete converter with missing fields: in.Shipping.Street, Shipping.Street` ToOrderDTOOutputAlias:"incompleteConverter"
+	out := models.OrderDTO{
+		ID: in.ID,
+		User: models.UserDTO{
+			ID: in.User.ID, Name: in.User.Name, Email: in.User.Email, Role: toRoleDTO(in.User.Role),
+		},
+	}
+	ship := &out.Shipping
+	ship.City = in.Shipping.City
+	return out
+}
+
+// ToOrderDTOCopiedOutput writes Street to a copy of the output's address, not to it.
+func ToOrderDTOCopiedOutput(in models.Order) models.OrderDTO { // want `ToOrderDTOCopiedOutput: incomplete converter with missing fields: Shipping.Street` ToOrderDTOCopiedOutput:"incompleteConverter"
+	out := models.OrderDTO{
+		ID: in.ID,
+		User: models.UserDTO{
+			ID: in.User.ID, Name: in.User.Name, Email: in.User.Email, Role: toRoleDTO(in.User.Role),
+		},
+		Shipping: models.AddressDTO{City: in.Shipping.City},
+	}
+	ship := out.Shipping
+	ship.Street = in.Shipping.Street
+	return out
+}
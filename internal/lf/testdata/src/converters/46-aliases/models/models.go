@@ -0,0 +1,47 @@
+package models
+
+type Role struct {
+	ID   int64
+	Name string
+}
+
+type User struct {
+	ID    int64
+	Name  string
+	Email string
+	Role  Role
+}
+
+type Address struct {
+	Street string
+	City   string
+}
+
+type Order struct {
+	ID       int64
+	User     User
+	Shipping Address
+}
+
+type RoleDTO struct {
+	ID   int64
+	Name string
+}
+
+type UserDTO struct {
+	ID    int64
+	Name  string
+	Email string
+	Role  RoleDTO
+}
+
+type AddressDTO struct {
+	Street string
+	City   string
+}
+
+type OrderDTO struct {
+	ID       int64
+	User     UserDTO
+	Shipping AddressDTO
+}
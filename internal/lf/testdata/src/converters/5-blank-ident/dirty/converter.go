@@ -7,7 +7,7 @@ import (
 // ConvertPerformanceMapSchemeModelToProto_WithoutBlankIdent converts PerformanceMapScheme to PerformanceMapSchemeReply
 // but WITHOUT using blank identifier to acknowledge the intentionally skipped field
 // This should report VenueConfiguration as missing
-func ConvertPerformanceMapSchemeModelToProto_WithoutBlankIdent(model *models.PerformanceMapScheme) *models.PerformanceMapSchemeReply { // want "incomplete converter"
+func ConvertPerformanceMapSchemeModelToProto_WithoutBlankIdent(model *models.PerformanceMapScheme) *models.PerformanceMapSchemeReply { // want "incomplete converter" ConvertPerformanceMapSchemeModelToProto_WithoutBlankIdent:"incompleteConverter"
 	if model == nil {
 		return &models.PerformanceMapSchemeReply{}
 	}
@@ -5,7 +5,7 @@ import (
 )
 
 // MatchedCategoryToProto_Incomplete converts MatchedCategory to MatchedCategoryProto but is incomplete
-func MatchedCategoryToProto_Incomplete(category *models.MatchedCategory) *models.MatchedCategoryProto { // want "incomplete converter"
+func MatchedCategoryToProto_Incomplete(category *models.MatchedCategory) *models.MatchedCategoryProto { // want "incomplete converter" MatchedCategoryToProto_Incomplete:"incompleteConverter"
 	if category == nil {
 		return nil
 	}
@@ -15,14 +15,14 @@ func MatchedCategoryToProto_Incomplete(category *models.MatchedCategory) *models
 }
 
 // MatchingDetailsToProto_Incomplete converts MatchingDetails to MatchingDetailsProto but is incomplete
-func MatchingDetailsToProto_Incomplete(details models.MatchingDetails) models.MatchingDetailsProto { // want "incomplete converter"
+func MatchingDetailsToProto_Incomplete(details models.MatchingDetails) models.MatchingDetailsProto { // want "incomplete converter" MatchingDetailsToProto_Incomplete:"incompleteConverter"
 	return models.MatchingDetailsProto{
 		// Missing: Info field
 	}
 }
 
 // MatchedMapDataToProto_Incomplete converts MatchedMapData to MatchedMapDataProto but is incomplete
-func MatchedMapDataToProto_Incomplete(data *models.MatchedMapData) *models.MatchedMapDataProto { // want "incomplete converter"
+func MatchedMapDataToProto_Incomplete(data *models.MatchedMapData) *models.MatchedMapDataProto { // want "incomplete converter" MatchedMapDataToProto_Incomplete:"incompleteConverter"
 	if data == nil {
 		return nil
 	}
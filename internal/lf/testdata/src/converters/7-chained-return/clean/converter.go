@@ -4,7 +4,7 @@ import "converters/7-chained-return/models"
 
 // InputToOutputDirect converts InputModel to OutputModel with direct composite literal return
 // This should work fine
-func InputToOutputDirect(input *models.InputModel) *models.OutputModel {
+func InputToOutputDirect(input *models.InputModel) *models.OutputModel { // want InputToOutputDirect:`fieldUsage\(input: Name, Value\)`
 	if input == nil {
 		return nil
 	}
@@ -18,7 +18,7 @@ func InputToOutputDirect(input *models.InputModel) *models.OutputModel {
 // InputToOutputChained converts InputModel to OutputModel with chained method call
 // This pattern returns (&Type{fields...}).MethodCall()
 // Currently this fails because the linter doesn't detect fields in chained calls
-func InputToOutputChained(input *models.InputModel) *models.OutputModel {
+func InputToOutputChained(input *models.InputModel) *models.OutputModel { // want InputToOutputChained:`fieldUsage\(input: Name, Value\)`
 	if input == nil {
 		return nil
 	}
@@ -31,7 +31,7 @@ func InputToOutputChained(input *models.InputModel) *models.OutputModel {
 
 // ProtoVenueConfigToModel converts proto to model with chained Prepare call
 // This should detect ALL fields in the composite literal and NOT flag as missing
-func ProtoVenueConfigToModel(config *models.VenueConfig) *models.VenueModel {
+func ProtoVenueConfigToModel(config *models.VenueConfig) *models.VenueModel { // want ProtoVenueConfigToModel:`fieldUsage\(config: Deprecated, ID, Name\)`
 	if config == nil {
 		return nil
 	}
@@ -49,7 +49,7 @@ func ProtoVenueConfigToModel(config *models.VenueConfig) *models.VenueModel {
 
 // ProtoVenueConfigToModelChainedNoNil - same converter but without nil check
 // Testing if nil check affects detection
-func ProtoVenueConfigToModelChainedNoNil(config *models.VenueConfig) *models.VenueModel {
+func ProtoVenueConfigToModelChainedNoNil(config *models.VenueConfig) *models.VenueModel { // want ProtoVenueConfigToModelChainedNoNil:`fieldUsage\(config: Deprecated, ID, Name\)`
 	return (&models.VenueModel{
 		ID:           config.ID,
 		Name:         config.Name,
@@ -64,7 +64,7 @@ func ProtoVenueConfigToModelChainedNoNil(config *models.VenueConfig) *models.Ven
 // ProtoVenueConfigToModelMissingFields converts proto to model with chained Prepare call
 // but intentionally doesn't set all output fields
 // This SHOULD flag as missing some output fields
-func ProtoVenueConfigToModelMissingFields(config *models.VenueConfig) *models.VenueModel {
+func ProtoVenueConfigToModelMissingFields(config *models.VenueConfig) *models.VenueModel { // want ProtoVenueConfigToModelMissingFields:`fieldUsage\(config: ID, Name\)`
 	if config == nil {
 		return nil
 	}
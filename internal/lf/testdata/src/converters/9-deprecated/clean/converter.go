@@ -1,14 +1,13 @@
 package sample_deprecated
 
-// Note: models live in the same package as the converter because deprecated-field
-// detection reads doc comments from the ASTs of the current analysis pass only.
-// Fields of types imported from other packages cannot be identified as deprecated.
+// Note: models live in the same package as the converter; see 37-deprecated-facts for
+// deprecated fields of imported types.
 
 // Event represents the input model.
 type Event struct {
 	ID string
 	// Deprecated: use Name instead
-	OldName  string
+	OldName  string // want OldName:"deprecated"
 	Name     string
 	Venue    string
 	Category string
@@ -18,7 +17,7 @@ type Event struct {
 type EventReply struct {
 	ID string
 	// Deprecated: use Name instead
-	OldName  string
+	OldName  string // want OldName:"deprecated"
 	Name     string
 	Venue    string
 	Category string
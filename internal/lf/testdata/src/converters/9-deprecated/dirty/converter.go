@@ -4,7 +4,7 @@ package sample_deprecated_dirty
 type Event struct {
 	ID string
 	// Deprecated: use Name instead
-	OldName  string
+	OldName  string // want OldName:"deprecated"
 	Name     string
 	Venue    string
 	Category string
@@ -14,7 +14,7 @@ type Event struct {
 type EventReply struct {
 	ID string
 	// Deprecated: use Name instead
-	OldName  string
+	OldName  string // want OldName:"deprecated"
 	Name     string
 	Venue    string
 	Category string
@@ -23,7 +23,7 @@ type EventReply struct {
 // ConvertEventToReplySkippingDeprecated skips the deprecated OldName field.
 // This file is exercised with include-deprecated=true, where deprecated fields
 // are validated like any other field, so skipping OldName is reported.
-func ConvertEventToReplySkippingDeprecated(model *Event) *EventReply { // want "incomplete converter with missing fields: model.OldName, OldName"
+func ConvertEventToReplySkippingDeprecated(model *Event) *EventReply { // want "incomplete converter with missing fields: model.OldName, OldName" ConvertEventToReplySkippingDeprecated:"incompleteConverter"
 	if model == nil {
 		return &EventReply{}
 	}
@@ -0,0 +1,4 @@
+// Package mapstructure stubs github.com/go-viper/mapstructure/v2 for the copy tests.
+package mapstructure
+
+func Decode(input interface{}, output interface{}) error { return nil }
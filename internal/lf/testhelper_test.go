@@ -65,7 +65,8 @@ func runAnalysisTest(t *testing.T, pkgPath string, assertions ...DiagnosticAsser
 }
 
 // extractMissingFields extracts the list of missing fields from a diagnostic message.
-// Expected format: "FunctionName: incomplete converter with missing fields: field1, field2, field3".
+// Expected format: "FunctionName: incomplete converter with missing fields: field1, field2, field3",
+// possibly followed by dataflow findings after a semicolon.
 func extractMissingFields(msg string) []string {
 	// Look for "missing fields: " in the message
 	marker := "missing fields: "
@@ -75,8 +76,8 @@ func extractMissingFields(msg string) []string {
 		return []string{}
 	}
 
-	// Extract everything after "missing fields: "
-	fieldsStr := after
+	// Extract everything after "missing fields: ", up to the dataflow findings
+	fieldsStr, _, _ := strings.Cut(after, ";")
 
 	// Split by comma and trim whitespace
 	var fields []string
@@ -15,8 +15,10 @@ package lostfield
 
 import (
 	"flag"
+	"strconv"
 
 	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/buildssa"
 
 	"github.com/amberpixels/lostfield/internal/config"
 	"github.com/amberpixels/lostfield/internal/lf"
@@ -36,6 +38,12 @@ type (
 	Format = config.Format
 	// FixMode controls whether diagnostics carry SuggestedFixes.
 	FixMode = config.FixMode
+	// ReceiverInput specifies when a method's receiver is the converter input.
+	ReceiverInput = config.ReceiverInput
+	// NameMatching specifies how input and output type names are paired.
+	NameMatching = config.NameMatching
+	// CopyHandling specifies what a reflective copy call in a converter means.
+	CopyHandling = config.CopyHandling
 )
 
 // Re-exported enum values, so importers never need the internal package.
@@ -53,6 +61,15 @@ const (
 	FixModeDisabled = config.FixModeDisabled
 	FixModeSafe     = config.FixModeSafe
 	FixModeSmart    = config.FixModeSmart
+
+	ReceiverFallback = config.ReceiverFallback
+	ReceiverPrefer   = config.ReceiverPrefer
+
+	NameMatchingContainment = config.NameMatchingContainment
+	NameMatchingTokens      = config.NameMatchingTokens
+
+	CopyHandlingMatch = config.CopyHandlingMatch
+	CopyHandlingTrust = config.CopyHandlingTrust
 )
 
 // DefaultConfig returns the default configuration.
@@ -93,7 +110,45 @@ func NewAnalyzerWithFlags() *analysis.Analyzer {
 
 	var fs flag.FlagSet
 	config.RegisterFlags(&fs, cfg)
+	// The analyzer is built before the flags are parsed: -dataflow decides on SSA as it
+	// is set, so only dataflow mode pays for building it.
+	if f := fs.Lookup("dataflow"); f != nil {
+		f.Value = dataflowFlag{analyzer: analyzer, cfg: cfg}
+	}
 	analyzer.Flags = fs
 
 	return analyzer
 }
+
+// dataflowFlag is the -dataflow flag of a CLI analyzer: setting it also sets whether
+// the analyzer requires SSA, which the driver reads only after parsing the flags.
+type dataflowFlag struct {
+	analyzer *analysis.Analyzer
+	cfg      *Config
+}
+
+// String returns the flag's value. flag.PrintDefaults calls it on a zero dataflowFlag
+// too, which has no config yet.
+func (f dataflowFlag) String() string {
+	if f.cfg == nil {
+		return "false"
+	}
+	return strconv.FormatBool(f.cfg.Dataflow)
+}
+
+// Set sets the flag, then the analyzer's requirements.
+func (f dataflowFlag) Set(s string) error {
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+	f.cfg.Dataflow = v
+	f.analyzer.Requires = nil
+	if f.cfg.Dataflow {
+		f.analyzer.Requires = []*analysis.Analyzer{buildssa.Analyzer}
+	}
+	return nil
+}
+
+// IsBoolFlag lets -dataflow go without a value, like any boolean flag.
+func (dataflowFlag) IsBoolFlag() bool { return true }
@@ -2,12 +2,14 @@ package lostfield_test
 
 import (
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/expectto/be"
 	"github.com/expectto/be/be_string"
 	. "github.com/onsi/gomega"
 	"golang.org/x/tools/go/analysis/analysistest"
+	"golang.org/x/tools/go/analysis/passes/buildssa"
 
 	"github.com/amberpixels/lostfield"
 )
@@ -50,3 +52,34 @@ func TestNewAnalyzerInvalidConfig(t *testing.T) {
 		be_string.ContainingSubstring("supported:"),
 	))
 }
+
+// TestNewAnalyzerWithFlagsDataflow verifies that the CLI analyzer requires SSA only
+// once -dataflow is set, so default runs do not build it.
+func TestNewAnalyzerWithFlagsDataflow(t *testing.T) {
+	g := NewWithT(t)
+
+	analyzer := lostfield.NewAnalyzerWithFlags()
+	g.Expect(analyzer.Requires).To(BeEmpty())
+
+	g.Expect(analyzer.Flags.Parse([]string{"-dataflow"})).To(Succeed())
+	g.Expect(analyzer.Requires).To(ConsistOf(buildssa.Analyzer))
+
+	g.Expect(analyzer.Flags.Set("dataflow", "false")).To(Succeed())
+	g.Expect(analyzer.Requires).To(BeEmpty())
+
+	g.Expect(analyzer.Flags.Set("dataflow", "maybe")).NotTo(Succeed())
+}
+
+// TestNewAnalyzerWithFlagsPrintDefaults prints the flags the way `lostfield help
+// lostfield` does, which formats a zero value of each flag type.
+func TestNewAnalyzerWithFlagsPrintDefaults(t *testing.T) {
+	g := NewWithT(t)
+
+	analyzer := lostfield.NewAnalyzerWithFlags()
+	var out strings.Builder
+	analyzer.Flags.SetOutput(&out)
+	analyzer.Flags.PrintDefaults()
+
+	g.Expect(out.String()).To(ContainSubstring("-dataflow"))
+	g.Expect(out.String()).NotTo(ContainSubstring("panic"))
+}
@@ -46,11 +46,20 @@ func init() {
 // Naming them here would let a user set them; leaving them out makes it impossible.
 type settings struct {
 	IncludeMethods        *bool    `json:"include-methods"`
+	ReceiverInput         *string  `json:"receiver-input"`
 	AllowGetters          *bool    `json:"allow-getters"`
+	SetterPatterns        []string `json:"setter-patterns"`
+	CopyFunctions         []string `json:"copy-functions"`
+	CopyHandling          *string  `json:"copy-handling"`
 	AllowAggregators      *bool    `json:"allow-aggregators"`
+	NestedDepth           *int     `json:"nested-depth"`
 	ExcludeFields         []string `json:"exclude-fields"`
 	ExcludeConverters     []string `json:"exclude-converters"`
 	OnlyConverters        []string `json:"only-converters"`
+	NameMatching          *string  `json:"name-matching"`
+	NameAffixes           []string `json:"name-affixes"`
+	TypePairs             []string `json:"type-pairs"`
+	PackagePairs          []string `json:"package-pairs"`
 	ExcludeFiles          []string `json:"exclude-files"`
 	MinSimilarity         *float64 `json:"min-similarity"`
 	IgnoreTags            []string `json:"ignore-tags"`
@@ -59,6 +68,10 @@ type settings struct {
 	IncludePrivateFields  *bool    `json:"include-private-fields"`
 	NonMarshallableFields *string  `json:"non-marshallable-fields"`
 	FieldValidationMode   *string  `json:"field-validation-mode"`
+	PathSensitive         *bool    `json:"path-sensitive"`
+	Dataflow              *bool    `json:"dataflow"`
+	SuspiciousMappings    *bool    `json:"suspicious-mappings"`
+	OverwrittenFields     *bool    `json:"overwritten-fields"`
 }
 
 // plugin adapts the lostfield analyzer to golangci-lint's LinterPlugin contract.
@@ -107,7 +120,14 @@ func (s *settings) applyTo(cfg *lostfield.Config) {
 	setBool(&cfg.IncludeGenerated, s.IncludeGenerated)
 	setBool(&cfg.IncludeDeprecated, s.IncludeDeprecated)
 	setBool(&cfg.IncludePrivateFields, s.IncludePrivateFields)
+	setBool(&cfg.PathSensitive, s.PathSensitive)
+	setBool(&cfg.Dataflow, s.Dataflow)
+	setBool(&cfg.SuspiciousMappings, s.SuspiciousMappings)
+	setBool(&cfg.OverwrittenFields, s.OverwrittenFields)
 
+	if s.NestedDepth != nil {
+		cfg.NestedDepth = *s.NestedDepth
+	}
 	if s.MinSimilarity != nil {
 		cfg.MinTypeNameSimilarity = *s.MinSimilarity
 	}
@@ -117,10 +137,24 @@ func (s *settings) applyTo(cfg *lostfield.Config) {
 	if s.FieldValidationMode != nil {
 		cfg.FieldValidationMode = lostfield.FieldValidationMode(*s.FieldValidationMode)
 	}
+	if s.ReceiverInput != nil {
+		cfg.ReceiverInput = lostfield.ReceiverInput(*s.ReceiverInput)
+	}
+	if s.NameMatching != nil {
+		cfg.NameMatching = lostfield.NameMatching(*s.NameMatching)
+	}
+	if s.CopyHandling != nil {
+		cfg.CopyHandling = lostfield.CopyHandling(*s.CopyHandling)
+	}
 
 	setSlice(&cfg.ExcludeFieldPatterns, s.ExcludeFields)
 	setSlice(&cfg.ExcludeConverterPatterns, s.ExcludeConverters)
 	setSlice(&cfg.OnlyConverterPatterns, s.OnlyConverters)
+	setSlice(&cfg.SetterPatterns, s.SetterPatterns)
+	setSlice(&cfg.CopyFunctions, s.CopyFunctions)
+	setSlice(&cfg.NameAffixes, s.NameAffixes)
+	setSlice(&cfg.TypePairs, s.TypePairs)
+	setSlice(&cfg.PackagePairs, s.PackagePairs)
 	setSlice(&cfg.ExcludeFilePatterns, s.ExcludeFiles)
 	setSlice(&cfg.IgnoreFieldTags, s.IgnoreTags)
 }
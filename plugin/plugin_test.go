@@ -39,7 +39,12 @@ func TestNewPluginOmittedKeysKeepDefaults(t *testing.T) {
 	g.Expect(cfg.AllowAggregators).To(BeTrue())
 	g.Expect(cfg.FieldValidationMode).To(Equal(lostfield.ModeStrict))
 	g.Expect(cfg.NonMarshallableFieldsHandling).To(Equal(lostfield.HandleAdaptive))
+	g.Expect(cfg.ReceiverInput).To(Equal(lostfield.ReceiverFallback))
+	g.Expect(cfg.NameMatching).To(Equal(lostfield.NameMatchingContainment))
 	g.Expect(cfg.ExcludeFilePatterns).To(Equal([]string{"*_test.go", "*.pb.go", "*/vendor/*"}))
+	g.Expect(cfg.SetterPatterns).To(Equal([]string{"Set*", "Clear*"}))
+	g.Expect(cfg.CopyHandling).To(Equal(lostfield.CopyHandlingMatch))
+	g.Expect(cfg.CopyFunctions).To(ContainElement("github.com/jinzhu/copier.Copy"))
 }
 
 func TestNewPluginAppliesSettings(t *testing.T) {
@@ -53,6 +58,19 @@ func TestNewPluginAppliesSettings(t *testing.T) {
 		"include-private-fields":  true,
 		"non-marshallable-fields": "strict",
 		"field-validation-mode":   "intersection",
+		"receiver-input":          "prefer",
+		"name-matching":           "tokens",
+		"name-affixes":            []string{"DTO", "Proto"},
+		"type-pairs":              []string{"domain.Account=api.ProfileResponse"},
+		"package-pairs":           []string{"*/domain=*/api"},
+		"path-sensitive":          true,
+		"dataflow":                true,
+		"suspicious-mappings":     true,
+		"overwritten-fields":      true,
+		"setter-patterns":         []string{"Set*", "With*"},
+		"copy-functions":          []string{"example.com/clone.Into"},
+		"copy-handling":           "trust",
+		"nested-depth":            2,
 	})
 
 	g.Expect(cfg.AllowMethodConverters).To(BeFalse())
@@ -63,6 +81,19 @@ func TestNewPluginAppliesSettings(t *testing.T) {
 	g.Expect(cfg.IncludePrivateFields).To(BeTrue())
 	g.Expect(cfg.NonMarshallableFieldsHandling).To(Equal(lostfield.HandleStrict))
 	g.Expect(cfg.FieldValidationMode).To(Equal(lostfield.ModeIntersection))
+	g.Expect(cfg.ReceiverInput).To(Equal(lostfield.ReceiverPrefer))
+	g.Expect(cfg.NameMatching).To(Equal(lostfield.NameMatchingTokens))
+	g.Expect(cfg.NameAffixes).To(Equal([]string{"DTO", "Proto"}))
+	g.Expect(cfg.TypePairs).To(Equal([]string{"domain.Account=api.ProfileResponse"}))
+	g.Expect(cfg.PackagePairs).To(Equal([]string{"*/domain=*/api"}))
+	g.Expect(cfg.PathSensitive).To(BeTrue())
+	g.Expect(cfg.Dataflow).To(BeTrue())
+	g.Expect(cfg.SuspiciousMappings).To(BeTrue())
+	g.Expect(cfg.OverwrittenFields).To(BeTrue())
+	g.Expect(cfg.SetterPatterns).To(Equal([]string{"Set*", "With*"}))
+	g.Expect(cfg.CopyFunctions).To(Equal([]string{"example.com/clone.Into"}))
+	g.Expect(cfg.CopyHandling).To(Equal(lostfield.CopyHandlingTrust))
+	g.Expect(cfg.NestedDepth).To(Equal(2))
 }
 
 // format, verbose and fix-mode are not part of the plugin's settings surface: they
@@ -115,6 +146,7 @@ func TestBuildAnalyzers(t *testing.T) {
 	g.Expect(err).NotTo(HaveOccurred())
 	g.Expect(analyzers).To(HaveLen(1))
 	g.Expect(analyzers[0].Name).To(Equal("lostfield"))
+	g.Expect(analyzers[0].FactTypes).NotTo(BeEmpty())
 
 	g.Expect(p.GetLoadMode()).To(Equal("typesinfo"))
 }